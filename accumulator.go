@@ -0,0 +1,85 @@
+package float8
+
+// Accumulator computes running statistics over a stream of Float8 values
+// without storing them.
+//
+// The sum, mean, and variance are accumulated in float32 with Welford's
+// method, matching MeanSlice and Variance over the same values. NaN inputs
+// are counted by NaNCount but otherwise ignored, so a single NaN does not
+// poison the statistics. The zero value is an empty Accumulator ready to use.
+//
+// An Accumulator is not safe for concurrent use.
+type Accumulator struct {
+	count, nanCount int
+	sum, mean, m2   float32
+	min, max        Float8
+}
+
+// Add adds f to the accumulated statistics.
+func (a *Accumulator) Add(f Float8) {
+	if f.IsNaN() {
+		a.nanCount++
+		return
+	}
+
+	a.count++
+	if a.count == 1 || Less(f, a.min) {
+		a.min = f
+	}
+	if a.count == 1 || Greater(f, a.max) {
+		a.max = f
+	}
+
+	x := f.ToFloat32()
+	a.sum += x
+	delta := x - a.mean
+	a.mean += delta / float32(a.count)
+	a.m2 += delta * (x - a.mean)
+}
+
+// Count returns the number of non-NaN values added.
+func (a *Accumulator) Count() int {
+	return a.count
+}
+
+// NaNCount returns the number of NaN values added.
+func (a *Accumulator) NaNCount() int {
+	return a.nanCount
+}
+
+// Sum returns the sum of the non-NaN values added, or PositiveZero if there
+// are none.
+func (a *Accumulator) Sum() Float8 {
+	return roundFloat32(a.sum, FlushToZero)
+}
+
+// Mean returns the mean of the non-NaN values added, or PositiveZero if there
+// are none.
+func (a *Accumulator) Mean() Float8 {
+	return roundFloat32(a.mean, FlushToZero)
+}
+
+// Min returns the smallest non-NaN value added, or NaN if there are none.
+func (a *Accumulator) Min() Float8 {
+	if a.count == 0 {
+		return NaN
+	}
+	return a.min
+}
+
+// Max returns the largest non-NaN value added, or NaN if there are none.
+func (a *Accumulator) Max() Float8 {
+	if a.count == 0 {
+		return NaN
+	}
+	return a.max
+}
+
+// Variance returns the population variance of the non-NaN values added, or
+// PositiveZero if fewer than two have been added.
+func (a *Accumulator) Variance() Float8 {
+	if a.count < 2 {
+		return PositiveZero
+	}
+	return roundFloat32(a.m2/float32(a.count), FlushToZero)
+}
@@ -0,0 +1,95 @@
+package float8
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func TestAccumulator(t *testing.T) {
+	s := []Float8{FromInt(2), FromInt(4), FromInt(4), FromInt(4), FromInt(5), FromInt(5), FromInt(7), FromInt(9)}
+
+	var acc Accumulator
+	for _, v := range s {
+		acc.Add(v)
+	}
+
+	if got := acc.Count(); got != len(s) {
+		t.Errorf("Count() = %d, want %d", got, len(s))
+	}
+	if got := acc.Sum(); got != SumSliceKahan(s) {
+		t.Errorf("Sum() = %v, want %v", got, SumSliceKahan(s))
+	}
+	if got := acc.Mean(); got != MeanSlice(s) {
+		t.Errorf("Mean() = %v, want %v", got, MeanSlice(s))
+	}
+	if got := acc.Variance(); got != Variance(s) {
+		t.Errorf("Variance() = %v, want %v", got, Variance(s))
+	}
+	if got := acc.Min(); got != FromInt(2) {
+		t.Errorf("Min() = %v, want 2", got)
+	}
+	if got := acc.Max(); got != FromInt(9) {
+		t.Errorf("Max() = %v, want 9", got)
+	}
+}
+
+func TestAccumulatorMatchesBatch(t *testing.T) {
+	r := rand.New(rand.NewPCG(9, 10))
+	s := make([]Float8, 2000)
+	for i := range s {
+		s[i] = ToFloat8(r.Float32()*8 - 2)
+	}
+
+	var acc Accumulator
+	for _, v := range s {
+		acc.Add(v)
+	}
+
+	if got, want := acc.Mean(), MeanSlice(s); got != want {
+		t.Errorf("Mean() = %v, want %v", got, want)
+	}
+	if got, want := acc.Variance(), Variance(s); got != want {
+		t.Errorf("Variance() = %v, want %v", got, want)
+	}
+	if got, want := acc.Sum(), SumSliceKahan(s); !AlmostEqualULP(got, want, 1) {
+		t.Errorf("Sum() = %v, want %v", got, want)
+	}
+	if got, want := acc.Min(), s[Argmin(s)]; got != want {
+		t.Errorf("Min() = %v, want %v", got, want)
+	}
+	if got, want := acc.Max(), s[Argmax(s)]; got != want {
+		t.Errorf("Max() = %v, want %v", got, want)
+	}
+}
+
+func TestAccumulatorNaN(t *testing.T) {
+	var acc Accumulator
+	for _, v := range []Float8{One(), NaN, FromInt(3), 0xFF} {
+		acc.Add(v)
+	}
+
+	if acc.Count() != 2 || acc.NaNCount() != 2 {
+		t.Errorf("Count(), NaNCount() = %d, %d, want 2, 2", acc.Count(), acc.NaNCount())
+	}
+	if got := acc.Mean(); got != FromInt(2) {
+		t.Errorf("Mean() = %v, want 2", got)
+	}
+	if got := acc.Variance(); got != One() {
+		t.Errorf("Variance() = %v, want 1", got)
+	}
+}
+
+func TestAccumulatorEmpty(t *testing.T) {
+	var acc Accumulator
+	if acc.Count() != 0 || acc.Sum() != PositiveZero || acc.Mean() != PositiveZero || acc.Variance() != PositiveZero {
+		t.Errorf("empty Accumulator = %d, %v, %v, %v, want 0, 0, 0, 0", acc.Count(), acc.Sum(), acc.Mean(), acc.Variance())
+	}
+	if !acc.Min().IsNaN() || !acc.Max().IsNaN() {
+		t.Errorf("empty Accumulator Min(), Max() = %v, %v, want NaN, NaN", acc.Min(), acc.Max())
+	}
+
+	acc.Add(FromInt(-5))
+	if acc.Min() != FromInt(-5) || acc.Max() != FromInt(-5) || acc.Variance() != PositiveZero {
+		t.Errorf("single value Min(), Max(), Variance() = %v, %v, %v", acc.Min(), acc.Max(), acc.Variance())
+	}
+}
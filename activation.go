@@ -0,0 +1,192 @@
+package float8
+
+import (
+	"math"
+)
+
+// Activation functions for Float8
+
+// Relu returns the rectified linear unit of f, max(0, f).
+//
+// Special cases are:
+//
+//	Relu(x) = +0 for x < 0 (including -Inf)
+//	Relu(±0) = +0
+//	Relu(+Inf) = +Inf
+//	Relu(NaN) = NaN
+func Relu(f Float8) Float8 {
+	if f.IsNaN() {
+		return f
+	}
+	if f.IsZero() || f.Sign() < 0 {
+		return PositiveZero
+	}
+	return f
+}
+
+// Sigmoid returns the logistic function 1/(1+e^-f).
+//
+// Special cases are:
+//
+//	Sigmoid(0) = 0.5
+//	Sigmoid(+Inf) = 1
+//	Sigmoid(-Inf) = +0
+//	Sigmoid(NaN) = NaN
+//
+// The result is computed in float64 and converted to Float8 through float32.
+// At 8-bit precision the result saturates quickly: it rounds to 1 for f above roughly 3.5 and
+// underflows through the subnormal range to zero for f below roughly -7.
+func Sigmoid(f Float8) Float8 {
+	if f.IsNaN() {
+		return f
+	}
+	if f == PositiveInfinity {
+		return One()
+	}
+	if f == NegativeInfinity {
+		return PositiveZero
+	}
+
+	f32 := f.ToFloat32()
+	result := float32(1 / (1 + math.Exp(-float64(f32))))
+	return ToFloat8(result)
+}
+
+// GELU returns the Gaussian error linear unit of f, f·Φ(f), computed as
+// 0.5·f·(1 + erf(f/√2)).
+//
+// Special cases are:
+//
+//	GELU(±0) = ±0
+//	GELU(+Inf) = +Inf
+//	GELU(-Inf) = -0
+//	GELU(NaN) = NaN
+//
+// The result is computed in float64 with math.Erf and converted to Float8
+// through float32, the same path the functions in math.go take. At 8-bit
+// precision GELU(f) rounds to f for f above roughly 2 and to -0 for f below roughly -3.5.
+func GELU(f Float8) Float8 {
+	if f.IsNaN() || f == PositiveInfinity {
+		return f
+	}
+	if f == NegativeInfinity {
+		return NegativeZero
+	}
+
+	x := float64(f.ToFloat32())
+	result := float32(0.5 * x * (1 + math.Erf(x/math.Sqrt2)))
+	return ToFloat8(result)
+}
+
+// GELUApprox returns the tanh approximation of GELU,
+// 0.5·f·(1 + tanh(√(2/π)·(f + 0.044715·f³))).
+//
+// It has the same special cases as GELU and agrees with it to within one
+// Float8 rounding step for every input.
+func GELUApprox(f Float8) Float8 {
+	if f.IsNaN() || f == PositiveInfinity {
+		return f
+	}
+	if f == NegativeInfinity {
+		return NegativeZero
+	}
+
+	x := float64(f.ToFloat32())
+	inner := math.Sqrt(2/math.Pi) * (x + 0.044715*x*x*x)
+	result := float32(0.5 * x * (1 + math.Tanh(inner)))
+	return ToFloat8(result)
+}
+
+// Softmax returns the softmax of s, exp(s[i]) / Σ exp(s[j]).
+//
+// The computation is numerically stable: the maximum element is subtracted
+// before exponentiating, and the exponentials, their sum, and the normalization
+// are all carried out in float32. Each output element is rounded to Float8 once,
+// so the outputs sum to 1 only within 8-bit tolerance.
+//
+// Special cases are:
+//   - An empty s returns an empty slice
+//   - If any element is NaN, every output element is NaN
+//   - If any element is +Inf, the probability mass is split evenly among the
+//     +Inf elements and every other element is zero
+//   - If every element is -Inf, every output element is NaN
+func Softmax(s []Float8) []Float8 {
+	result := make([]Float8, len(s))
+	if len(s) == 0 {
+		return result
+	}
+
+	maxVal := float32(math.Inf(-1))
+	for _, v := range s {
+		if v.IsNaN() {
+			for i := range result {
+				result[i] = NaN
+			}
+			return result
+		}
+		maxVal = max(maxVal, v.ToFloat32())
+	}
+
+	if math.IsInf(float64(maxVal), -1) {
+		for i := range result {
+			result[i] = NaN
+		}
+		return result
+	}
+
+	exps := make([]float32, len(s))
+	var sum float32
+	for i, v := range s {
+		x := v.ToFloat32()
+		if math.IsInf(float64(maxVal), 1) {
+			// Only the +Inf elements carry probability mass
+			if math.IsInf(float64(x), 1) {
+				exps[i] = 1
+			}
+		} else {
+			exps[i] = float32(math.Exp(float64(x - maxVal)))
+		}
+		sum += exps[i]
+	}
+
+	for i, e := range exps {
+		result[i] = ToFloat8(e / sum)
+	}
+	return result
+}
+
+// ReluSlice applies Relu to each element of s and returns a new slice.
+func ReluSlice(s []Float8) []Float8 {
+	result := make([]Float8, len(s))
+	for i, v := range s {
+		result[i] = Relu(v)
+	}
+	return result
+}
+
+// SigmoidSlice applies Sigmoid to each element of s and returns a new slice.
+func SigmoidSlice(s []Float8) []Float8 {
+	result := make([]Float8, len(s))
+	for i, v := range s {
+		result[i] = Sigmoid(v)
+	}
+	return result
+}
+
+// TanhSlice applies Tanh to each element of s and returns a new slice.
+func TanhSlice(s []Float8) []Float8 {
+	result := make([]Float8, len(s))
+	for i, v := range s {
+		result[i] = Tanh(v)
+	}
+	return result
+}
+
+// GELUSlice applies GELU to each element of s and returns a new slice.
+func GELUSlice(s []Float8) []Float8 {
+	result := make([]Float8, len(s))
+	for i, v := range s {
+		result[i] = GELU(v)
+	}
+	return result
+}
@@ -0,0 +1,245 @@
+package float8
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRelu(t *testing.T) {
+	tests := []struct {
+		name  string
+		input Float8
+		want  Float8
+	}{
+		{"positive", ToFloat8(2.5), ToFloat8(2.5)},
+		{"negative", ToFloat8(-2.5), PositiveZero},
+		{"positive zero", PositiveZero, PositiveZero},
+		{"negative zero", NegativeZero, PositiveZero},
+		{"negative subnormal", Float8(0x81), PositiveZero},
+		{"infinity", PositiveInfinity, PositiveInfinity},
+		{"negative infinity", NegativeInfinity, PositiveZero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Relu(tt.input); got != tt.want {
+				t.Errorf("Relu(%v) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	if got := Relu(NaN); !got.IsNaN() {
+		t.Errorf("Relu(NaN) = %v, want NaN", got)
+	}
+}
+
+func TestSigmoid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input Float8
+		want  Float8
+	}{
+		{"midpoint", PositiveZero, ToFloat8(0.5)},
+		{"negative zero midpoint", NegativeZero, ToFloat8(0.5)},
+		{"saturates to one", ToFloat8(8.0), One()},
+		{"large positive", MaxValue, One()},
+		{"large negative", MinValue, PositiveZero},
+		{"infinity", PositiveInfinity, One()},
+		{"negative infinity", NegativeInfinity, PositiveZero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sigmoid(tt.input); got != tt.want {
+				t.Errorf("Sigmoid(%v) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	if got := Sigmoid(NaN); !got.IsNaN() {
+		t.Errorf("Sigmoid(NaN) = %v, want NaN", got)
+	}
+
+	// Sigmoid is monotonic and bounded by [0, 1] over all finite inputs
+	prev := float32(-1)
+	for _, f := range []float32{-16, -4, -2, -1, -0.5, 0, 0.5, 1, 2, 4, 16} {
+		got := Sigmoid(ToFloat8(f)).ToFloat32()
+		if got < 0 || got > 1 {
+			t.Errorf("Sigmoid(%g) = %g, want value in [0, 1]", f, got)
+		}
+		if got < prev {
+			t.Errorf("Sigmoid(%g) = %g, not monotonic (previous %g)", f, got, prev)
+		}
+		prev = got
+	}
+}
+
+func TestTanh(t *testing.T) {
+	tests := []struct {
+		name  string
+		input Float8
+		want  Float8
+	}{
+		{"positive zero", PositiveZero, PositiveZero},
+		{"negative zero", NegativeZero, NegativeZero},
+		{"one", One(), ToFloat8(float32(math.Tanh(1)))},
+		{"negative one", FromInt(-1), ToFloat8(float32(math.Tanh(-1)))},
+		{"saturates", ToFloat8(8.0), One()},
+		{"infinity", PositiveInfinity, One()},
+		{"negative infinity", NegativeInfinity, FromInt(-1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Tanh(tt.input); got != tt.want {
+				t.Errorf("Tanh(%v) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	if got := Tanh(NaN); !got.IsNaN() {
+		t.Errorf("Tanh(NaN) = %v, want NaN", got)
+	}
+}
+
+func TestGELU(t *testing.T) {
+	tests := []struct {
+		name  string
+		input Float8
+		want  Float8
+	}{
+		{"positive zero", PositiveZero, PositiveZero},
+		{"negative zero", NegativeZero, NegativeZero},
+		{"one", One(), ToFloat8(0.8413)},
+		{"negative one", FromInt(-1), ToFloat8(-0.1587)},
+		{"large positive is identity", FromInt(8), FromInt(8)},
+		{"max value is identity", MaxValue, MaxValue},
+		{"large negative", FromInt(-8), NegativeZero},
+		{"min value", MinValue, NegativeZero},
+		{"infinity", PositiveInfinity, PositiveInfinity},
+		{"negative infinity", NegativeInfinity, NegativeZero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GELU(tt.input); got != tt.want {
+				t.Errorf("GELU(%v) = %v, want %v", tt.input, got, tt.want)
+			}
+			if got := GELUApprox(tt.input); got != tt.want {
+				t.Errorf("GELUApprox(%v) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	if got := GELU(NaN); !got.IsNaN() {
+		t.Errorf("GELU(NaN) = %v, want NaN", got)
+	}
+	if got := GELUApprox(NaN); !got.IsNaN() {
+		t.Errorf("GELUApprox(NaN) = %v, want NaN", got)
+	}
+
+	// The tanh approximation stays within one rounding step of the exact form
+	for _, f := range AllFiniteValues() {
+		if !AlmostEqualULP(GELU(f), GELUApprox(f), 1) {
+			t.Errorf("GELUApprox(%v) = %v, GELU = %v", f, GELUApprox(f), GELU(f))
+		}
+	}
+}
+
+func TestActivationSlices(t *testing.T) {
+	input := []Float8{ToFloat8(-2.0), PositiveZero, ToFloat8(2.0), NaN}
+
+	tests := []struct {
+		name  string
+		slice func([]Float8) []Float8
+		elem  func(Float8) Float8
+	}{
+		{"ReluSlice", ReluSlice, Relu},
+		{"SigmoidSlice", SigmoidSlice, Sigmoid},
+		{"TanhSlice", TanhSlice, Tanh},
+		{"GELUSlice", GELUSlice, GELU},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.slice(input)
+			if len(got) != len(input) {
+				t.Fatalf("%s returned %d elements, want %d", tt.name, len(got), len(input))
+			}
+			for i, v := range input {
+				want := tt.elem(v)
+				if got[i] != want && !(got[i].IsNaN() && want.IsNaN()) {
+					t.Errorf("%s()[%d] = %v, want %v", tt.name, i, got[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestSoftmax(t *testing.T) {
+	t.Run("sums to one", func(t *testing.T) {
+		input := []Float8{ToFloat8(1.0), ToFloat8(2.0), ToFloat8(3.0), ToFloat8(0.5)}
+		got := Softmax(input)
+		if len(got) != len(input) {
+			t.Fatalf("Softmax returned %d elements, want %d", len(got), len(input))
+		}
+
+		var sum float32
+		argmax := 0
+		for i, v := range got {
+			sum += v.ToFloat32()
+			if Greater(v, got[argmax]) {
+				argmax = i
+			}
+		}
+		// Each output carries up to ~6% relative rounding error
+		if math.Abs(float64(sum-1)) > 0.1 {
+			t.Errorf("Softmax outputs sum to %g, want ≈1", sum)
+		}
+		if argmax != 2 {
+			t.Errorf("largest probability at index %d, want 2", argmax)
+		}
+	})
+
+	t.Run("large inputs do not overflow", func(t *testing.T) {
+		got := Softmax([]Float8{MaxValue, MaxValue})
+		for i, v := range got {
+			if v != ToFloat8(0.5) {
+				t.Errorf("Softmax(max, max)[%d] = %v, want 0.5", i, v)
+			}
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		got := Softmax([]Float8{})
+		if got == nil || len(got) != 0 {
+			t.Errorf("Softmax([]) = %v, want empty non-nil slice", got)
+		}
+	})
+
+	t.Run("NaN propagates", func(t *testing.T) {
+		for i, v := range Softmax([]Float8{One(), NaN, One()}) {
+			if !v.IsNaN() {
+				t.Errorf("Softmax with NaN input [%d] = %v, want NaN", i, v)
+			}
+		}
+	})
+
+	t.Run("infinity takes all mass", func(t *testing.T) {
+		got := Softmax([]Float8{One(), PositiveInfinity, MaxValue})
+		want := []Float8{PositiveZero, One(), PositiveZero}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("Softmax with +Inf input [%d] = %v, want %v", i, got[i], want[i])
+			}
+		}
+	})
+
+	t.Run("all negative infinity", func(t *testing.T) {
+		for i, v := range Softmax([]Float8{NegativeInfinity, NegativeInfinity}) {
+			if !v.IsNaN() {
+				t.Errorf("Softmax(-Inf, -Inf)[%d] = %v, want NaN", i, v)
+			}
+		}
+	})
+}
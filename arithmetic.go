@@ -1,7 +1,9 @@
 package float8
 
 import (
+	"fmt"
 	"math"
+	"sync/atomic"
 )
 
 // Global arithmetic mode
@@ -34,7 +36,7 @@ func Add(a, b Float8) Float8 {
 //   - ArithmeticAlgorithmic: Uses the algorithmic implementation
 //
 // Special cases are handled according to IEEE 754 rules:
-//   - If either operand is NaN, the result is NaN
+//   - If either operand is NaN, the result is that NaN operand (a if both are)
 //   - Infinities of the same sign add to infinity of that sign
 //   - Infinities of opposite signs produce NaN
 //   - The sign of a zero result is the sign of the sum of the operands
@@ -44,12 +46,14 @@ func Add(a, b Float8) Float8 {
 // rounded to the value with an even least significant bit (round-to-nearest-even).
 func AddWithMode(a, b Float8, mode ArithmeticMode) Float8 {
 	// Use lookup table if available and mode allows it
-	if (mode == ArithmeticAuto || mode == ArithmeticLookup) && addTable != nil {
-		return addTable[uint16(a)<<8|uint16(b)]
+	if mode == ArithmeticAuto || mode == ArithmeticLookup {
+		if tables := arithTables.Load(); tables != nil {
+			return tables.add[uint16(a)<<8|uint16(b)]
+		}
 	}
 
 	// Fall back to algorithmic implementation
-	return addAlgorithmic(a, b)
+	return addAlgorithmic(a, b, FlushToZero)
 }
 
 // Sub returns the difference of a-b, i.e., the result of subtracting b from a.
@@ -75,12 +79,14 @@ func Sub(a, b Float8) Float8 {
 // SubWithMode performs subtraction with specified arithmetic mode
 func SubWithMode(a, b Float8, mode ArithmeticMode) Float8 {
 	// Use lookup table if available and mode allows it
-	if (mode == ArithmeticAuto || mode == ArithmeticLookup) && subTable != nil {
-		return subTable[uint16(a)<<8|uint16(b)]
+	if mode == ArithmeticAuto || mode == ArithmeticLookup {
+		if tables := arithTables.Load(); tables != nil {
+			return tables.sub[uint16(a)<<8|uint16(b)]
+		}
 	}
 
 	// Fall back to algorithmic implementation
-	return subAlgorithmic(a, b)
+	return subAlgorithmic(a, b, FlushToZero)
 }
 
 // Mul returns the product of the operands a and b.
@@ -106,12 +112,14 @@ func Mul(a, b Float8) Float8 {
 // MulWithMode performs multiplication with specified arithmetic mode
 func MulWithMode(a, b Float8, mode ArithmeticMode) Float8 {
 	// Use lookup table if available and mode allows it
-	if (mode == ArithmeticAuto || mode == ArithmeticLookup) && mulTable != nil {
-		return mulTable[uint16(a)<<8|uint16(b)]
+	if mode == ArithmeticAuto || mode == ArithmeticLookup {
+		if tables := arithTables.Load(); tables != nil {
+			return tables.mul[uint16(a)<<8|uint16(b)]
+		}
 	}
 
 	// Fall back to algorithmic implementation
-	return mulAlgorithmic(a, b)
+	return mulAlgorithmic(a, b, FlushToZero)
 }
 
 // Div returns the quotient a/b of the operands a and b.
@@ -134,23 +142,104 @@ func Div(a, b Float8) Float8 {
 	return DivWithMode(a, b, DefaultArithmeticMode)
 }
 
-// DivWithMode performs division with specified arithmetic mode
+// DivWithMode performs division with specified arithmetic mode.
+//
+// In ArithmeticAuto and ArithmeticLookup modes the full division table is used
+// if EnableFastArithmetic has built it. Otherwise, if EnableReciprocalTable is
+// in effect, the quotient is computed as a * Reciprocal(b) with the reciprocal
+// looked up, which rounds twice and so can differ from true division; see
+// EnableReciprocalTable.
 func DivWithMode(a, b Float8, mode ArithmeticMode) Float8 {
 	// Use lookup table if available and mode allows it
-	if (mode == ArithmeticAuto || mode == ArithmeticLookup) && divTable != nil {
-		return divTable[uint16(a)<<8|uint16(b)]
+	if mode == ArithmeticAuto || mode == ArithmeticLookup {
+		if tables := arithTables.Load(); tables != nil {
+			return tables.div[uint16(a)<<8|uint16(b)]
+		}
+		if recip := recipTable.Load(); recip != nil {
+			return mulAlgorithmic(a, recip[b], FlushToZero)
+		}
 	}
 
 	// Fall back to algorithmic implementation
-	return divAlgorithmic(a, b)
+	return divAlgorithmic(a, b, FlushToZero)
+}
+
+// Method forms of the arithmetic operations and comparisons, for chaining
+// such as a.Add(b).Mul(c). Each delegates to the function of the same name, so it
+// uses DefaultArithmeticMode and has the same special cases.
+
+// Add returns f+g; see Add.
+func (f Float8) Add(g Float8) Float8 {
+	return Add(f, g)
+}
+
+// Sub returns f-g; see Sub.
+func (f Float8) Sub(g Float8) Float8 {
+	return Sub(f, g)
+}
+
+// Mul returns f*g; see Mul.
+func (f Float8) Mul(g Float8) Float8 {
+	return Mul(f, g)
+}
+
+// Div returns f/g; see Div.
+func (f Float8) Div(g Float8) Float8 {
+	return Div(f, g)
+}
+
+// Less reports whether f < g; see Less.
+func (f Float8) Less(g Float8) bool {
+	return Less(f, g)
+}
+
+// Greater reports whether f > g; see Greater.
+func (f Float8) Greater(g Float8) bool {
+	return Greater(f, g)
+}
+
+// Equal reports whether f == g by value; see Equal.
+func (f Float8) Equal(g Float8) bool {
+	return Equal(f, g)
+}
+
+// Min returns the smaller of f and g; see Min.
+func (f Float8) Min(g Float8) Float8 {
+	return Min(f, g)
+}
+
+// Max returns the larger of f and g; see Max.
+func (f Float8) Max(g Float8) Float8 {
+	return Max(f, g)
+}
+
+// Reciprocal returns 1/f, rounded to the nearest Float8.
+//
+// Special cases are:
+//
+//	Reciprocal(±0) = ±Inf
+//	Reciprocal(±Inf) = ±0
+//	Reciprocal(NaN) = NaN
+func Reciprocal(f Float8) Float8 {
+	return divAlgorithmic(One(), f, FlushToZero)
 }
 
 // Algorithmic implementations
 
-func addAlgorithmic(a, b Float8) Float8 {
+// propagateNaN returns the NaN operand of a and b, preferring a, so that an
+// operation on NaN keeps the encoding of its input instead of normalizing
+// NegativeNaN to NaN.
+func propagateNaN(a, b Float8) Float8 {
+	if a.IsNaN() {
+		return a
+	}
+	return b
+}
+
+func addAlgorithmic(a, b Float8, flush bool) Float8 {
 	// Handle NaN cases first — NaN propagates through all operations
 	if a.IsNaN() || b.IsNaN() {
-		return NaN
+		return propagateNaN(a, b)
 	}
 
 	// Handle zero cases with IEEE 754 sign rules: (+0) + (-0) = +0
@@ -186,13 +275,13 @@ func addAlgorithmic(a, b Float8) Float8 {
 	f32b := b.ToFloat32()
 	result := f32a + f32b
 
-	return ToFloat8(result)
+	return roundFloat32(result, flush)
 }
 
-func subAlgorithmic(a, b Float8) Float8 {
+func subAlgorithmic(a, b Float8, flush bool) Float8 {
 	// Handle NaN cases first — NaN propagates through all operations
 	if a.IsNaN() || b.IsNaN() {
-		return NaN
+		return propagateNaN(a, b)
 	}
 
 	// Handle zero cases with IEEE 754 sign rules: (-0) - (-0) = +0
@@ -225,13 +314,13 @@ func subAlgorithmic(a, b Float8) Float8 {
 	f32b := b.ToFloat32()
 	result := f32a - f32b
 
-	return ToFloat8(result)
+	return roundFloat32(result, flush)
 }
 
-func mulAlgorithmic(a, b Float8) Float8 {
+func mulAlgorithmic(a, b Float8, flush bool) Float8 {
 	// Handle NaN cases - any operation with NaN results in NaN
 	if a.IsNaN() || b.IsNaN() {
-		return NaN
+		return propagateNaN(a, b)
 	}
 
 	// Get signs before any potential conversions
@@ -273,13 +362,13 @@ func mulAlgorithmic(a, b Float8) Float8 {
 	result := f32a * f32b
 
 	// Final conversion may produce NaN or infinity, which is fine
-	return ToFloat8(result)
+	return roundFloat32(result, flush)
 }
 
-func divAlgorithmic(a, b Float8) Float8 {
+func divAlgorithmic(a, b Float8, flush bool) Float8 {
 	// Handle NaN cases first (NaN op anything = NaN)
 	if a.IsNaN() || b.IsNaN() {
-		return NaN
+		return propagateNaN(a, b)
 	}
 
 	// Handle division by zero
@@ -346,7 +435,14 @@ func divAlgorithmic(a, b Float8) Float8 {
 		return NegativeInfinity
 	}
 
-	return ToFloat8(result)
+	return roundFloat32(result, flush)
+}
+
+// roundFloat32 rounds the float32 result of an arithmetic operation to Float8.
+// Arithmetic always saturates on overflow; strict mode only applies to conversions.
+func roundFloat32(f32 float32, flush bool) Float8 {
+	result, _ := toFloat8(f32, ModeDefault, flush)
+	return result
 }
 
 // Comparison operations
@@ -380,6 +476,35 @@ func Equal(a, b Float8) bool {
 	return a == b
 }
 
+// AlmostEqual reports whether a and b differ by at most tol, that is
+// |a-b| <= tol, with the difference computed in float32.
+//
+// NaN is not almost equal to anything, including itself. An infinity is only
+// almost equal to an infinity of the same sign, whatever the tolerance.
+func AlmostEqual(a, b, tol Float8) bool {
+	if a.IsNaN() || b.IsNaN() {
+		return false
+	}
+	if a.IsInf() || b.IsInf() {
+		return a == b
+	}
+	diff := float32(math.Abs(float64(a.ToFloat32() - b.ToFloat32())))
+	return diff <= tol.ToFloat32()
+}
+
+// AlmostEqualULP reports whether b can be reached from a in at most ulps
+// steps of NextAfter, that is |ULPDistance(a, b)| <= ulps.
+//
+// NaN is not almost equal to anything. Zeros of either sign count as the same
+// value, so a walk across zero costs one step on each side of it.
+func AlmostEqualULP(a, b Float8, ulps int) bool {
+	if a.IsNaN() || b.IsNaN() {
+		return false
+	}
+	d := ULPDistance(a, b)
+	return d <= ulps && -d <= ulps
+}
+
 // Less returns true if a < b
 func Less(a, b Float8) bool {
 	// Handle NaN cases - any comparison with NaN is false
@@ -428,7 +553,7 @@ func GreaterEqual(a, b Float8) bool {
 }
 
 // Min returns the smaller of two Float8 values.
-// If either value is NaN, returns NaN.
+// If either value is NaN, returns that NaN operand (a if both are).
 // Min(+Inf, x) returns x (if x is finite or -Inf)
 // Min(-Inf, x) returns -Inf
 // Min(x, +Inf) returns x (if x is finite or -Inf)
@@ -436,7 +561,7 @@ func GreaterEqual(a, b Float8) bool {
 func Min(a, b Float8) Float8 {
 	// Handle NaN cases first
 	if a.IsNaN() || b.IsNaN() {
-		return NaN
+		return propagateNaN(a, b)
 	}
 
 	// Handle infinities
@@ -460,7 +585,7 @@ func Min(a, b Float8) Float8 {
 }
 
 // Max returns the larger of two Float8 values.
-// If either value is NaN, returns NaN.
+// If either value is NaN, returns that NaN operand (a if both are).
 // Max(+Inf, x) returns +Inf
 // Max(-Inf, x) returns x (if x is finite or +Inf)
 // Max(x, +Inf) returns +Inf
@@ -468,7 +593,7 @@ func Min(a, b Float8) Float8 {
 func Max(a, b Float8) Float8 {
 	// Handle NaN cases first
 	if a.IsNaN() || b.IsNaN() {
-		return NaN
+		return propagateNaN(a, b)
 	}
 
 	// Handle infinities
@@ -491,6 +616,20 @@ func Max(a, b Float8) Float8 {
 	}
 }
 
+// MinN returns the smallest of vals, folding them with Min, so it returns the
+// first NaN if any value is NaN. With no arguments MinN returns +Inf, the
+// identity of Min.
+func MinN(vals ...Float8) Float8 {
+	return ReduceSlice(vals, PositiveInfinity, Min)
+}
+
+// MaxN returns the largest of vals, folding them with Max, so it returns the
+// first NaN if any value is NaN. With no arguments MaxN returns -Inf, the
+// identity of Max.
+func MaxN(vals ...Float8) Float8 {
+	return ReduceSlice(vals, NegativeInfinity, Max)
+}
+
 // Batch operations for slices
 
 // AddSlice performs element-wise addition of two Float8 slices.
@@ -566,6 +705,307 @@ func ScaleSlice(s []Float8, scalar Float8) []Float8 {
 	return result
 }
 
+// AddScalar returns a new slice with scalar added to each element of s.
+func AddScalar(s []Float8, scalar Float8) []Float8 {
+	result := make([]Float8, len(s))
+	for i := range s {
+		result[i] = Add(s[i], scalar)
+	}
+	return result
+}
+
+// AddScalarInPlace adds scalar to each element of s.
+func AddScalarInPlace(s []Float8, scalar Float8) {
+	for i := range s {
+		s[i] = Add(s[i], scalar)
+	}
+}
+
+// SubScalar returns a new slice with scalar subtracted from each element of s.
+func SubScalar(s []Float8, scalar Float8) []Float8 {
+	result := make([]Float8, len(s))
+	for i := range s {
+		result[i] = Sub(s[i], scalar)
+	}
+	return result
+}
+
+// SubScalarInPlace subtracts scalar from each element of s.
+func SubScalarInPlace(s []Float8, scalar Float8) {
+	for i := range s {
+		s[i] = Sub(s[i], scalar)
+	}
+}
+
+// MulScalar returns a new slice with each element of s multiplied by scalar.
+// It is the same as ScaleSlice, named to match the other scalar operations.
+func MulScalar(s []Float8, scalar Float8) []Float8 {
+	return ScaleSlice(s, scalar)
+}
+
+// MulScalarInPlace multiplies each element of s by scalar.
+func MulScalarInPlace(s []Float8, scalar Float8) {
+	for i := range s {
+		s[i] = Mul(s[i], scalar)
+	}
+}
+
+// DivScalar returns a new slice with each element of s divided by scalar.
+func DivScalar(s []Float8, scalar Float8) []Float8 {
+	result := make([]Float8, len(s))
+	for i := range s {
+		result[i] = Div(s[i], scalar)
+	}
+	return result
+}
+
+// DivScalarInPlace divides each element of s by scalar.
+func DivScalarInPlace(s []Float8, scalar Float8) {
+	for i := range s {
+		s[i] = Div(s[i], scalar)
+	}
+}
+
+// Affine returns a new slice with scale*x + bias computed for each element x
+// of s, rounding once per element with FMA.
+func Affine(s []Float8, scale, bias Float8) []Float8 {
+	result := make([]Float8, len(s))
+	for i := range s {
+		result[i] = FMA(scale, s[i], bias)
+	}
+	return result
+}
+
+// AffineVec returns a new slice with scale[i]*s[i] + bias[i] computed for
+// each element, rounding once per element with FMA. It panics if the three
+// slices do not all have the same length.
+func AffineVec(s, scale, bias []Float8) []Float8 {
+	if len(s) != len(scale) || len(s) != len(bias) {
+		panic("float8: slice length mismatch")
+	}
+
+	result := make([]Float8, len(s))
+	for i := range s {
+		result[i] = FMA(scale[i], s[i], bias[i])
+	}
+	return result
+}
+
+// AddBroadcast performs element-wise addition of a and b with broadcasting:
+// if either slice has length 1, its single element is added to every element
+// of the other, as when adding a bias. Otherwise the slices must have the
+// same length.
+//
+// Unlike AddSlice, mismatched lengths are reported as an error rather than a
+// panic, since they typically come from tensor shapes chosen at run time.
+func AddBroadcast(a, b []Float8) ([]Float8, error) {
+	return broadcast(a, b, Add)
+}
+
+// MulBroadcast performs element-wise multiplication of a and b with the same
+// broadcasting rules as AddBroadcast.
+func MulBroadcast(a, b []Float8) ([]Float8, error) {
+	return broadcast(a, b, Mul)
+}
+
+// broadcast applies op element-wise to a and b, repeating a length-1 operand
+// across the other.
+func broadcast(a, b []Float8, op func(a, b Float8) Float8) ([]Float8, error) {
+	switch {
+	case len(a) == len(b):
+		result := make([]Float8, len(a))
+		for i := range a {
+			result[i] = op(a[i], b[i])
+		}
+		return result, nil
+	case len(a) == 1:
+		result := make([]Float8, len(b))
+		for i := range b {
+			result[i] = op(a[0], b[i])
+		}
+		return result, nil
+	case len(b) == 1:
+		result := make([]Float8, len(a))
+		for i := range a {
+			result[i] = op(a[i], b[0])
+		}
+		return result, nil
+	}
+	return nil, &Float8Error{Op: "broadcast", Msg: fmt.Sprintf("cannot broadcast lengths %d and %d", len(a), len(b))}
+}
+
+// ClampSlice returns a new slice with each element of s restricted to the
+// range [min, max] using Clamp.
+//
+// NaN elements are passed through unchanged, since NaN compares neither less
+// than min nor greater than max.
+func ClampSlice(s []Float8, min, max Float8) []Float8 {
+	result := make([]Float8, len(s))
+	for i := range s {
+		result[i] = Clamp(s[i], min, max)
+	}
+	return result
+}
+
+// ClampSliceInPlace restricts each element of s to the range [min, max],
+// overwriting s. NaN elements are left unchanged, as in ClampSlice.
+func ClampSliceInPlace(s []Float8, min, max Float8) {
+	for i := range s {
+		s[i] = Clamp(s[i], min, max)
+	}
+}
+
+// Clamp01Slice returns a new slice with each element of s restricted to the
+// range [0, 1] with Clamp01.
+func Clamp01Slice(s []Float8) []Float8 {
+	return ClampSlice(s, PositiveZero, One())
+}
+
+// ClampToFiniteSlice returns a new slice with each infinite element of s
+// replaced by MaxValue or MinValue using ClampToFinite. NaN elements are
+// passed through unchanged; use SanitizeSlice to replace them as well.
+func ClampToFiniteSlice(s []Float8) []Float8 {
+	result := make([]Float8, len(s))
+	for i := range s {
+		result[i] = s[i].ClampToFinite()
+	}
+	return result
+}
+
+// AbsSlice returns a new slice with the absolute value of each element of s.
+func AbsSlice(s []Float8) []Float8 {
+	result := make([]Float8, len(s))
+	for i := range s {
+		result[i] = s[i].Abs()
+	}
+	return result
+}
+
+// AbsSliceInPlace replaces each element of s with its absolute value.
+func AbsSliceInPlace(s []Float8) {
+	for i := range s {
+		s[i] = s[i].Abs()
+	}
+}
+
+// NegSlice returns a new slice with each element of s negated.
+//
+// Zeros keep their sign, matching Neg.
+func NegSlice(s []Float8) []Float8 {
+	result := make([]Float8, len(s))
+	for i := range s {
+		result[i] = s[i].Neg()
+	}
+	return result
+}
+
+// NegSliceInPlace negates each element of s. Zeros keep their sign, matching Neg.
+func NegSliceInPlace(s []Float8) {
+	for i := range s {
+		s[i] = s[i].Neg()
+	}
+}
+
+// SanitizeSlice returns a new slice in which NaN elements are replaced by
+// nanRepl, +Inf by posInfRepl, and -Inf by negInfRepl. Finite elements are
+// copied unchanged.
+func SanitizeSlice(s []Float8, nanRepl, posInfRepl, negInfRepl Float8) []Float8 {
+	result := make([]Float8, len(s))
+	copy(result, s)
+	SanitizeSliceInPlace(result, nanRepl, posInfRepl, negInfRepl)
+	return result
+}
+
+// SanitizeSliceInPlace replaces NaN elements of s with nanRepl, +Inf with
+// posInfRepl, and -Inf with negInfRepl, leaving finite elements unchanged.
+func SanitizeSliceInPlace(s []Float8, nanRepl, posInfRepl, negInfRepl Float8) {
+	for i, v := range s {
+		switch {
+		case v.IsNaN():
+			s[i] = nanRepl
+		case v == PositiveInfinity:
+			s[i] = posInfRepl
+		case v == NegativeInfinity:
+			s[i] = negInfRepl
+		}
+	}
+}
+
+// ReplaceNaN replaces every NaN element of s with repl, in place.
+func ReplaceNaN(s []Float8, repl Float8) {
+	for i, v := range s {
+		if v.IsNaN() {
+			s[i] = repl
+		}
+	}
+}
+
+// MapSlice returns a new slice with fn applied to each element of s.
+//
+// Example:
+//
+//	roots := MapSlice(s, Sqrt)
+func MapSlice(s []Float8, fn func(Float8) Float8) []Float8 {
+	result := make([]Float8, len(s))
+	for i := range s {
+		result[i] = fn(s[i])
+	}
+	return result
+}
+
+// MapSliceInPlace replaces each element of s with fn applied to it.
+func MapSliceInPlace(s []Float8, fn func(Float8) Float8) {
+	for i := range s {
+		s[i] = fn(s[i])
+	}
+}
+
+// ReduceSlice folds s from left to right, starting from init and combining
+// the accumulator with each element using fn. It returns init if s is empty.
+//
+// Example:
+//
+//	largest := ReduceSlice(s, NegativeInfinity, Max)
+func ReduceSlice(s []Float8, init Float8, fn func(acc, x Float8) Float8) Float8 {
+	acc := init
+	for _, v := range s {
+		acc = fn(acc, v)
+	}
+	return acc
+}
+
+// Where returns a new slice holding a[i] where cond[i] is true and b[i]
+// otherwise. Values are copied unmodified, including NaN and infinities.
+// It panics if cond, a, and b do not all have the same length.
+func Where(cond []bool, a, b []Float8) []Float8 {
+	if len(a) != len(cond) || len(b) != len(cond) {
+		panic("float8: slice length mismatch")
+	}
+	result := make([]Float8, len(cond))
+	for i, c := range cond {
+		if c {
+			result[i] = a[i]
+		} else {
+			result[i] = b[i]
+		}
+	}
+	return result
+}
+
+// WhereScalar returns a new slice holding a where cond[i] is true and b
+// otherwise.
+func WhereScalar(cond []bool, a, b Float8) []Float8 {
+	result := make([]Float8, len(cond))
+	for i, c := range cond {
+		if c {
+			result[i] = a
+		} else {
+			result[i] = b
+		}
+	}
+	return result
+}
+
 // SumSlice returns the sum of all elements in the slice.
 //
 // This function computes the sum of all Float8 values in the input slice.
@@ -582,6 +1022,10 @@ func ScaleSlice(s []Float8, scalar Float8) []Float8 {
 //   - If the slice is empty, returns PositiveZero.
 //   - If any element is NaN, the result is NaN.
 //
+// Each partial sum is rounded to Float8, so once the running sum grows, small
+// terms are lost entirely. Use SumSliceKahan for long slices where accuracy
+// matters.
+//
 // Example:
 //
 //	s := []Float8{1.0, 2.0, 3.0, 4.0}
@@ -594,38 +1038,204 @@ func SumSlice(s []Float8) Float8 {
 	return sum
 }
 
-// Lookup tables (loaded lazily)
-var (
-	addTable []Float8
-	subTable []Float8
-	mulTable []Float8
-	divTable []Float8
-)
+// SumSliceKahan returns the sum of all elements in the slice using Kahan
+// compensated summation.
+//
+// The sum is accumulated in float32 with a running compensation term and
+// rounded to Float8 once at the end, so it stays accurate where SumSlice
+// drops small terms. Special values follow the same rules as Add: NaN
+// poisons the sum, and opposite infinities produce NaN.
+//
+// Example:
+//
+//	s := make([]Float8, 1000)
+//	for i := range s {
+//		s[i] = ToFloat8(0.1)
+//	}
+//	sum := SumSliceKahan(s) // Close to 100, where SumSlice stalls far below
+func SumSliceKahan(s []Float8) Float8 {
+	var sum, c float32
+	for _, v := range s {
+		y := v.ToFloat32() - c
+		t := sum + y
+		if math.IsInf(float64(t), 0) {
+			// The compensation is meaningless once the sum is infinite
+			// and would turn into NaN on the next step.
+			c = 0
+		} else {
+			c = (t - sum) - y
+		}
+		sum = t
+	}
+	return roundFloat32(sum, FlushToZero)
+}
+
+// pairwiseBlockSize is the segment length below which SumSlicePairwise sums
+// with a simple loop instead of splitting further.
+const pairwiseBlockSize = 16
+
+// SumSlicePairwise returns the sum of all elements in the slice using
+// pairwise (tree) summation.
+//
+// The slice is split recursively into halves whose float32 sums are
+// combined, with segments of up to 16 elements summed directly. Error grows
+// with the logarithm of the length rather than linearly, at a lower cost than
+// SumSliceKahan. The result is rounded to Float8 once at the end, and special
+// values follow the same rules as Add.
+func SumSlicePairwise(s []Float8) Float8 {
+	return roundFloat32(sumPairwise(s), FlushToZero)
+}
 
-// EnableFastArithmetic enables lookup tables for arithmetic operations
+// sumPairwise returns the float32 pairwise sum of s.
+func sumPairwise(s []Float8) float32 {
+	if len(s) <= pairwiseBlockSize {
+		var sum float32
+		for _, v := range s {
+			sum += v.ToFloat32()
+		}
+		return sum
+	}
+	mid := len(s) / 2
+	return sumPairwise(s[:mid]) + sumPairwise(s[mid:])
+}
+
+// arithmeticTables holds the precomputed result of every binary operation,
+// indexed by uint16(a)<<8 | uint16(b)
+type arithmeticTables struct {
+	add [65536]Float8
+	sub [65536]Float8
+	mul [65536]Float8
+	div [65536]Float8
+}
+
+// Lookup tables (loaded lazily). The pointer is swapped atomically so the
+// arithmetic hot paths can read it without locking; writers hold tablesMu.
+var arithTables atomic.Pointer[arithmeticTables]
+
+// arithGeneration counts the calls that disable or reconfigure the arithmetic
+// tables. EnableFastArithmeticAsync compares it before and after its build so
+// that a finished build never overrides a later DisableFastArithmetic or
+// Configure. Guarded by tablesMu.
+var arithGeneration uint64
+
+// EnableFastArithmetic enables lookup tables for arithmetic operations.
+// It is safe to call concurrently with arithmetic on other goroutines.
 func EnableFastArithmetic() {
+	tablesMu.Lock()
+	defer tablesMu.Unlock()
 	initArithmeticTables()
 }
 
-// DisableFastArithmetic disables lookup tables and uses algorithmic operations
+// EnableFastArithmeticAsync enables lookup tables for arithmetic operations
+// like EnableFastArithmetic, but builds them in a new goroutine and returns
+// immediately. The returned channel is closed once the tables are in place.
+// Until then, arithmetic keeps using the algorithmic implementation, which
+// gives the same results.
+//
+// The tables are built without holding the lock that EnableFastArithmetic,
+// DisableFastArithmetic, and Configure take, so those calls are not delayed.
+// If DisableFastArithmetic or Configure is called after EnableFastArithmeticAsync
+// returns but before the build finishes, the built tables are discarded and
+// that later call decides whether tables are enabled.
+func EnableFastArithmeticAsync() <-chan struct{} {
+	done := make(chan struct{})
+
+	tablesMu.Lock()
+	gen, flush, ready := arithGeneration, FlushToZero, arithTables.Load() != nil
+	tablesMu.Unlock()
+	if ready {
+		close(done)
+		return done
+	}
+
+	go func() {
+		defer close(done)
+
+		tables := buildArithmeticTables(flush)
+
+		tablesMu.Lock()
+		defer tablesMu.Unlock()
+		switch {
+		case gen != arithGeneration:
+			// Disabled or reconfigured during the build
+		case arithTables.Load() != nil:
+			// Enabled by someone else in the meantime
+		case flush != FlushToZero:
+			// The setting changed during the build, so the tables are stale
+			initArithmeticTables()
+		default:
+			arithTables.Store(tables)
+		}
+	}()
+	return done
+}
+
+// DisableFastArithmetic disables lookup tables and uses algorithmic operations.
+// It is safe to call concurrently with arithmetic on other goroutines.
 func DisableFastArithmetic() {
-	addTable = nil
-	subTable = nil
-	mulTable = nil
-	divTable = nil
+	tablesMu.Lock()
+	defer tablesMu.Unlock()
+	arithGeneration++
+	arithTables.Store(nil)
 }
 
-// initArithmeticTables initializes all arithmetic lookup tables
+// initArithmeticTables initializes all arithmetic lookup tables.
+// Callers must hold tablesMu or otherwise exclude concurrent writers.
 func initArithmeticTables() {
-	if addTable != nil {
+	if arithTables.Load() != nil {
 		return // Already initialized
 	}
 
-	// Initialize tables with 65536 entries each (256 * 256)
-	addTable = make([]Float8, 65536)
-	subTable = make([]Float8, 65536)
-	mulTable = make([]Float8, 65536)
-	divTable = make([]Float8, 65536)
+	arithTables.Store(buildArithmeticTables(FlushToZero))
+}
+
+// Reciprocal table (loaded lazily), swapped atomically like arithTables
+var recipTable atomic.Pointer[[256]Float8]
+
+// EnableReciprocalTable enables a 256-entry table of Reciprocal values that
+// Div uses, when the full division table of EnableFastArithmetic is not
+// enabled, to compute a/b as a * Reciprocal(b). It needs 256 bytes instead of
+// 64 KiB, at the cost of accuracy: the reciprocal is rounded before the
+// multiplication, so the quotient is rounded twice. About a fifth of finite
+// operand pairs give a different result from true division. When both the
+// reciprocal and the quotient are normal the error is at most one step;
+// larger errors come from subnormal reciprocals, which have fewer bits, and
+// from the smallest subnormals, whose reciprocals overflow to Inf, so that
+// dividing such a value by itself gives Inf instead of 1.
+//
+// The table bakes in the current FlushToZero setting. It is safe to call
+// concurrently with arithmetic on other goroutines.
+func EnableReciprocalTable() {
+	tablesMu.Lock()
+	defer tablesMu.Unlock()
+	if recipTable.Load() == nil {
+		recipTable.Store(buildReciprocalTable(FlushToZero))
+	}
+}
+
+// DisableReciprocalTable disables the reciprocal table, so Div falls back to
+// exact division. It is safe to call concurrently with arithmetic on other
+// goroutines.
+func DisableReciprocalTable() {
+	tablesMu.Lock()
+	defer tablesMu.Unlock()
+	recipTable.Store(nil)
+}
+
+// buildReciprocalTable computes 1/f for every Float8 f with the given
+// flush-to-zero setting
+func buildReciprocalTable(flush bool) *[256]Float8 {
+	table := new([256]Float8)
+	for i := range table {
+		table[i] = divAlgorithmic(One(), Float8(i), flush)
+	}
+	return table
+}
+
+// buildArithmeticTables computes the add, sub, mul, and div lookup tables
+// using the algorithmic implementations with the given flush-to-zero setting
+func buildArithmeticTables(flush bool) *arithmeticTables {
+	tables := new(arithmeticTables)
 
 	for a := 0; a < 256; a++ {
 		for b := 0; b < 256; b++ {
@@ -633,10 +1243,11 @@ func initArithmeticTables() {
 			f8a := Float8(a)
 			f8b := Float8(b)
 
-			addTable[idx] = addAlgorithmic(f8a, f8b)
-			subTable[idx] = subAlgorithmic(f8a, f8b)
-			mulTable[idx] = mulAlgorithmic(f8a, f8b)
-			divTable[idx] = divAlgorithmic(f8a, f8b)
+			tables.add[idx] = addAlgorithmic(f8a, f8b, flush)
+			tables.sub[idx] = subAlgorithmic(f8a, f8b, flush)
+			tables.mul[idx] = mulAlgorithmic(f8a, f8b, flush)
+			tables.div[idx] = divAlgorithmic(f8a, f8b, flush)
 		}
 	}
+	return tables
 }
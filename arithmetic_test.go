@@ -1,6 +1,9 @@
 package float8
 
 import (
+	"errors"
+	"math"
+	"slices"
 	"testing"
 )
 
@@ -201,7 +204,7 @@ func TestDisableFastArithmetic(t *testing.T) {
 	EnableFastArithmetic()
 
 	// Verify tables are initialized
-	if addTable == nil || subTable == nil || mulTable == nil || divTable == nil {
+	if arithTables.Load() == nil {
 		t.Error("Expected tables to be initialized after EnableFastArithmetic")
 	}
 
@@ -209,7 +212,7 @@ func TestDisableFastArithmetic(t *testing.T) {
 	DisableFastArithmetic()
 
 	// Verify tables are nil after disabling
-	if addTable != nil || subTable != nil || mulTable != nil || divTable != nil {
+	if arithTables.Load() != nil {
 		t.Error("Expected tables to be nil after DisableFastArithmetic")
 	}
 
@@ -222,6 +225,226 @@ func TestDisableFastArithmetic(t *testing.T) {
 	}
 }
 
+func TestEnableFastArithmeticAsync(t *testing.T) {
+	DisableFastArithmetic()
+	defer DisableFastArithmetic()
+
+	check := func(when string) {
+		t.Helper()
+		for a := 0; a < 256; a += 3 {
+			for b := 0; b < 256; b += 5 {
+				fa, fb := Float8(a), Float8(b)
+				if got, want := Add(fa, fb), addAlgorithmic(fa, fb, FlushToZero); got != want {
+					t.Fatalf("%s: Add(0x%02X, 0x%02X) = 0x%02X, want 0x%02X", when, a, b, uint8(got), uint8(want))
+				}
+				if got, want := Div(fa, fb), divAlgorithmic(fa, fb, FlushToZero); got != want {
+					t.Fatalf("%s: Div(0x%02X, 0x%02X) = 0x%02X, want 0x%02X", when, a, b, uint8(got), uint8(want))
+				}
+			}
+		}
+	}
+
+	done := EnableFastArithmeticAsync()
+	check("while building")
+	<-done
+	if arithTables.Load() == nil {
+		t.Fatal("Expected tables to be initialized after the channel closed")
+	}
+	check("after building")
+
+	// With the tables already enabled the channel closes without rebuilding
+	tables := arithTables.Load()
+	<-EnableFastArithmeticAsync()
+	if arithTables.Load() != tables {
+		t.Error("EnableFastArithmeticAsync replaced existing tables")
+	}
+
+	// A disable issued while the build runs wins over the finished build
+	DisableFastArithmetic()
+	done = EnableFastArithmeticAsync()
+	DisableFastArithmetic()
+	<-done
+	if arithTables.Load() != nil {
+		t.Error("Expected tables to stay disabled after DisableFastArithmetic during the build")
+	}
+
+	// So does a Configure that disables fast arithmetic
+	done = EnableFastArithmeticAsync()
+	Configure(DefaultConfig())
+	<-done
+	if arithTables.Load() != nil {
+		t.Error("Expected tables to stay disabled after Configure during the build")
+	}
+}
+
+func TestArithmeticMethods(t *testing.T) {
+	if got := One().Add(One()).Mul(ToFloat8(3.0)); got != FromInt(6) {
+		t.Errorf("One().Add(One()).Mul(3) = %v, want 6", got)
+	}
+	if got := FromInt(10).Sub(FromInt(4)).Div(FromInt(3)); got != FromInt(2) {
+		t.Errorf("10.Sub(4).Div(3) = %v, want 2", got)
+	}
+
+	orig := DefaultArithmeticMode
+	defer func() { DefaultArithmeticMode = orig }()
+
+	// The reciprocal table makes table-based division observably different
+	EnableReciprocalTable()
+	for _, tt := range []struct {
+		mode ArithmeticMode
+		want Float8
+	}{
+		{ArithmeticAuto, PositiveInfinity},
+		{ArithmeticAlgorithmic, One()},
+	} {
+		DefaultArithmeticMode = tt.mode
+		if got := SmallestPositive.Div(SmallestPositive); got != tt.want {
+			t.Errorf("mode %v: min.Div(min) = %v, want %v", tt.mode, got, tt.want)
+		}
+	}
+	DisableReciprocalTable()
+
+	defer DisableFastArithmetic()
+	for _, mode := range []ArithmeticMode{ArithmeticAlgorithmic, ArithmeticAuto, ArithmeticLookup} {
+		DefaultArithmeticMode = mode
+		EnableFastArithmetic()
+		for a := 0; a < 256; a += 7 {
+			for b := 0; b < 256; b += 11 {
+				fa, fb := Float8(a), Float8(b)
+				for _, op := range []struct {
+					name      string
+					got, want Float8
+				}{
+					{"Add", fa.Add(fb), AddWithMode(fa, fb, mode)},
+					{"Sub", fa.Sub(fb), SubWithMode(fa, fb, mode)},
+					{"Mul", fa.Mul(fb), MulWithMode(fa, fb, mode)},
+					{"Div", fa.Div(fb), DivWithMode(fa, fb, mode)},
+				} {
+					if op.got != op.want {
+						t.Fatalf("mode %v: 0x%02X.%s(0x%02X) = 0x%02X, want 0x%02X", mode, a, op.name, b, uint8(op.got), uint8(op.want))
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestComparisonMethods(t *testing.T) {
+	specials := []Float8{
+		PositiveZero, NegativeZero, One(), FromInt(-3), SmallestPositive,
+		MaxValue, MinValue, PositiveInfinity, NegativeInfinity, NaN, NegativeNaN,
+	}
+	for _, a := range specials {
+		for _, b := range specials {
+			if got, want := a.Less(b), Less(a, b); got != want {
+				t.Errorf("%v.Less(%v) = %v, want %v", a, b, got, want)
+			}
+			if got, want := a.Greater(b), Greater(a, b); got != want {
+				t.Errorf("%v.Greater(%v) = %v, want %v", a, b, got, want)
+			}
+			if got, want := a.Equal(b), Equal(a, b); got != want {
+				t.Errorf("%v.Equal(%v) = %v, want %v", a, b, got, want)
+			}
+			if got, want := a.Min(b), Min(a, b); got != want {
+				t.Errorf("%v.Min(%v) = 0x%02X, want 0x%02X", a, b, uint8(got), uint8(want))
+			}
+			if got, want := a.Max(b), Max(a, b); got != want {
+				t.Errorf("%v.Max(%v) = 0x%02X, want 0x%02X", a, b, uint8(got), uint8(want))
+			}
+		}
+	}
+}
+
+func TestReciprocal(t *testing.T) {
+	tests := []struct {
+		name string
+		f    Float8
+		want Float8
+	}{
+		{"one", One(), One()},
+		{"two", FromInt(2), ToFloat8(0.5)},
+		{"negative", FromInt(-4), ToFloat8(-0.25)},
+		{"rounded", FromInt(3), ToFloat8(1.0 / 3)},
+		{"positive zero", PositiveZero, PositiveInfinity},
+		{"negative zero", NegativeZero, NegativeInfinity},
+		{"negative infinity", NegativeInfinity, NegativeZero},
+		{"smallest subnormal overflows", SmallestPositive, PositiveInfinity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Reciprocal(tt.f); got != tt.want {
+				t.Errorf("Reciprocal(%v) = %v, want %v", tt.f, got, tt.want)
+			}
+		})
+	}
+	if got := Reciprocal(NegativeNaN); got != NegativeNaN {
+		t.Errorf("Reciprocal(-NaN) = %v, want -NaN", got)
+	}
+}
+
+func TestReciprocalTableDivision(t *testing.T) {
+	EnableReciprocalTable()
+	defer DisableReciprocalTable()
+	if recipTable.Load() == nil {
+		t.Fatal("Expected reciprocal table to be initialized after EnableReciprocalTable")
+	}
+
+	var total, differ, beyondOneStep int
+	for a := 0; a < 256; a++ {
+		for b := 0; b < 256; b++ {
+			fa, fb := Float8(a), Float8(b)
+			got, want := Div(fa, fb), divAlgorithmic(fa, fb, FlushToZero)
+			if recip := Mul(fa, Reciprocal(fb)); got != recip {
+				t.Fatalf("Div(%v, %v) = %v, want a*Reciprocal(b) = %v", fa, fb, got, recip)
+			}
+			if !fa.IsFinite() || !fb.IsFinite() || fb.IsZero() {
+				// Special cases agree exactly, up to the sign of zero
+				if got != want && !(got.IsZero() && want.IsZero()) && !(got.IsNaN() && want.IsNaN()) {
+					t.Errorf("Div(%v, %v) = %v, want %v", fa, fb, got, want)
+				}
+				continue
+			}
+
+			total++
+			if got == want || (got.IsZero() && want.IsZero()) {
+				continue
+			}
+			differ++
+			if d := ULPDistance(got, want); d > 1 {
+				beyondOneStep++
+				if Reciprocal(fb).IsNormal() && want.IsNormal() {
+					t.Errorf("Div(%v, %v) = %v, %d steps from %v with a normal reciprocal", fa, fb, got, d, want)
+				}
+			}
+		}
+	}
+	t.Logf("reciprocal division differs from true division for %d of %d finite pairs, %d by more than one step",
+		differ, total, beyondOneStep)
+	if differ*4 > total {
+		t.Errorf("%d of %d pairs differ, want at most a quarter", differ, total)
+	}
+
+	// The full division table takes precedence over the reciprocal table
+	EnableFastArithmetic()
+	defer DisableFastArithmetic()
+	if got := Div(SmallestPositive, SmallestPositive); got != One() {
+		t.Errorf("Div(min, min) with both tables = %v, want 1", got)
+	}
+	DisableFastArithmetic()
+	if got := Div(SmallestPositive, SmallestPositive); got != PositiveInfinity {
+		t.Errorf("Div(min, min) with the reciprocal table = %v, want +Inf", got)
+	}
+	if got := DivWithMode(SmallestPositive, SmallestPositive, ArithmeticAlgorithmic); got != One() {
+		t.Errorf("DivWithMode(min, min, ArithmeticAlgorithmic) = %v, want 1", got)
+	}
+
+	DisableReciprocalTable()
+	if recipTable.Load() != nil {
+		t.Error("Expected reciprocal table to be nil after DisableReciprocalTable")
+	}
+}
+
 // TestDivisionEdgeCases tests edge cases in division to achieve 100% coverage
 func TestDivisionEdgeCases(t *testing.T) {
 	tests := []struct {
@@ -263,3 +486,628 @@ func TestDivisionEdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestSumSliceKahan(t *testing.T) {
+	t.Run("many small terms", func(t *testing.T) {
+		s := make([]Float8, 1000)
+		for i := range s {
+			s[i] = ToFloat8(0.1)
+		}
+
+		naive := SumSlice(s).ToFloat32()
+		kahan := SumSliceKahan(s).ToFloat32()
+
+		naiveErr := math.Abs(float64(naive) - 100)
+		kahanErr := math.Abs(float64(kahan) - 100)
+		if kahanErr >= naiveErr {
+			t.Errorf("SumSliceKahan error %g not smaller than SumSlice error %g", kahanErr, naiveErr)
+		}
+		if kahanErr > 10 {
+			t.Errorf("SumSliceKahan = %g, want close to 100", kahan)
+		}
+	})
+
+	tests := []struct {
+		name     string
+		s        []Float8
+		expected Float8
+	}{
+		{"empty", nil, PositiveZero},
+		{"regular numbers", []Float8{One(), FromInt(2), FromInt(3)}, FromInt(6)},
+		{"infinity", []Float8{PositiveInfinity, One(), FromInt(-2)}, PositiveInfinity},
+		{"overflow", []Float8{MaxValue, MaxValue}, PositiveInfinity},
+		{"opposite infinities", []Float8{PositiveInfinity, NegativeInfinity}, NaN},
+		{"NaN", []Float8{One(), NaN, One()}, NaN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := SumSliceKahan(tt.s)
+			if tt.expected.IsNaN() {
+				if !result.IsNaN() {
+					t.Errorf("SumSliceKahan(%v) = %v, want NaN", tt.s, result)
+				}
+			} else if result != tt.expected {
+				t.Errorf("SumSliceKahan(%v) = %v, want %v", tt.s, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSumSlicePairwise(t *testing.T) {
+	t.Run("mixed magnitudes", func(t *testing.T) {
+		s := make([]Float8, 1024)
+		var want float64
+		for i := range s {
+			switch i % 4 {
+			case 0:
+				s[i] = ToFloat8(0.015625)
+			case 1:
+				s[i] = ToFloat8(0.25)
+			case 2:
+				s[i] = ToFloat8(-0.125)
+			default:
+				s[i] = ToFloat8(0.5)
+			}
+			want += float64(s[i].ToFloat32())
+		}
+
+		naive := SumSlice(s).ToFloat32()
+		pairwise := SumSlicePairwise(s).ToFloat32()
+		reference := ToFloat8(float32(want)).ToFloat32()
+
+		if pairwise != reference {
+			t.Errorf("SumSlicePairwise = %g, want %g (float32 reference %g)", pairwise, reference, want)
+		}
+		naiveErr := math.Abs(float64(naive) - want)
+		pairwiseErr := math.Abs(float64(pairwise) - want)
+		if pairwiseErr >= naiveErr {
+			t.Errorf("SumSlicePairwise error %g not smaller than SumSlice error %g", pairwiseErr, naiveErr)
+		}
+	})
+
+	tests := []struct {
+		name     string
+		s        []Float8
+		expected Float8
+	}{
+		{"empty", nil, PositiveZero},
+		{"regular numbers", []Float8{One(), FromInt(2), FromInt(3)}, FromInt(6)},
+		{"infinity", []Float8{PositiveInfinity, One(), FromInt(-2)}, PositiveInfinity},
+		{"opposite infinities", []Float8{PositiveInfinity, NegativeInfinity}, NaN},
+		{"NaN", []Float8{One(), NaN, One()}, NaN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := SumSlicePairwise(tt.s)
+			if tt.expected.IsNaN() {
+				if !result.IsNaN() {
+					t.Errorf("SumSlicePairwise(%v) = %v, want NaN", tt.s, result)
+				}
+			} else if result != tt.expected {
+				t.Errorf("SumSlicePairwise(%v) = %v, want %v", tt.s, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestClampSlice(t *testing.T) {
+	lo, hi := FromInt(-2), FromInt(2)
+	s := []Float8{FromInt(-5), One(), FromInt(3), NaN, NegativeInfinity, PositiveInfinity, NegativeZero}
+	expected := []Float8{lo, One(), hi, NaN, lo, hi, NegativeZero}
+
+	result := ClampSlice(s, lo, hi)
+	if len(result) != len(expected) {
+		t.Fatalf("Expected result length %d, got %d", len(expected), len(result))
+	}
+	for i := range result {
+		if expected[i].IsNaN() {
+			if !result[i].IsNaN() {
+				t.Errorf("At index %d: expected NaN, got %v", i, result[i])
+			}
+		} else if result[i] != expected[i] {
+			t.Errorf("At index %d: expected %v, got %v", i, expected[i], result[i])
+		}
+	}
+	if s[0] != FromInt(-5) {
+		t.Error("ClampSlice modified its input")
+	}
+
+	ClampSliceInPlace(s, lo, hi)
+	for i := range s {
+		if s[i] != result[i] {
+			t.Errorf("ClampSliceInPlace at index %d: got 0x%02x, want 0x%02x", i, uint8(s[i]), uint8(result[i]))
+		}
+	}
+}
+
+func TestClampToFiniteSlice(t *testing.T) {
+	s := []Float8{PositiveInfinity, One(), NaN, NegativeInfinity, NegativeZero}
+	expected := []Float8{MaxValue, One(), NaN, MinValue, NegativeZero}
+
+	result := ClampToFiniteSlice(s)
+	for i := range expected {
+		if result[i] != expected[i] {
+			t.Errorf("ClampToFiniteSlice at index %d: got 0x%02x, want 0x%02x", i, uint8(result[i]), uint8(expected[i]))
+		}
+	}
+	if s[0] != PositiveInfinity {
+		t.Error("ClampToFiniteSlice modified its input")
+	}
+}
+
+func TestAbsSlice(t *testing.T) {
+	s := []Float8{FromInt(-3), FromInt(2), NegativeZero, NegativeInfinity, NaN}
+	expected := []Float8{FromInt(3), FromInt(2), PositiveZero, PositiveInfinity, NaN}
+
+	result := AbsSlice(s)
+	for i := range result {
+		if expected[i].IsNaN() {
+			if !result[i].IsNaN() {
+				t.Errorf("At index %d: expected NaN, got %v", i, result[i])
+			}
+		} else if result[i] != expected[i] {
+			t.Errorf("At index %d: expected 0x%02x, got 0x%02x", i, uint8(expected[i]), uint8(result[i]))
+		}
+	}
+
+	AbsSliceInPlace(s)
+	for i := range s {
+		if s[i] != result[i] {
+			t.Errorf("AbsSliceInPlace at index %d: got 0x%02x, want 0x%02x", i, uint8(s[i]), uint8(result[i]))
+		}
+	}
+}
+
+func TestNegSlice(t *testing.T) {
+	s := []Float8{FromInt(-3), FromInt(2), PositiveZero, NegativeZero, PositiveInfinity}
+	expected := []Float8{FromInt(3), FromInt(-2), PositiveZero, NegativeZero, NegativeInfinity}
+
+	result := NegSlice(s)
+	for i := range result {
+		if result[i] != expected[i] {
+			t.Errorf("At index %d: expected 0x%02x, got 0x%02x", i, uint8(expected[i]), uint8(result[i]))
+		}
+	}
+	if !NegSlice([]Float8{NaN})[0].IsNaN() {
+		t.Error("NegSlice(NaN) is not NaN")
+	}
+
+	NegSliceInPlace(s)
+	for i := range s {
+		if s[i] != expected[i] {
+			t.Errorf("NegSliceInPlace at index %d: got 0x%02x, want 0x%02x", i, uint8(s[i]), uint8(expected[i]))
+		}
+	}
+}
+
+func TestMapSlice(t *testing.T) {
+	s := []Float8{FromInt(4), FromInt(9), FromInt(16), PositiveZero, FromInt(-1)}
+	result := MapSlice(s, Sqrt)
+	if len(result) != len(s) {
+		t.Fatalf("Expected result length %d, got %d", len(s), len(result))
+	}
+	for i := range s {
+		want := Sqrt(s[i])
+		if result[i] != want && !(result[i].IsNaN() && want.IsNaN()) {
+			t.Errorf("At index %d: expected %v, got %v", i, want, result[i])
+		}
+	}
+	if result[0] != FromInt(2) || result[1] != FromInt(3) || result[2] != FromInt(4) {
+		t.Errorf("MapSlice(s, Sqrt) = %v, want [2 3 4 ...]", result)
+	}
+	if s[0] != FromInt(4) {
+		t.Error("MapSlice modified its input")
+	}
+
+	MapSliceInPlace(s, func(f Float8) Float8 { return Mul(f, FromInt(2)) })
+	expected := []Float8{FromInt(8), FromInt(18), FromInt(32), PositiveZero, FromInt(-2)}
+	for i := range s {
+		if s[i] != expected[i] {
+			t.Errorf("MapSliceInPlace at index %d: got %v, want %v", i, s[i], expected[i])
+		}
+	}
+}
+
+func TestReduceSlice(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        []Float8
+		expected Float8
+	}{
+		{"empty", nil, NegativeInfinity},
+		{"single", []Float8{FromInt(3)}, FromInt(3)},
+		{"regular numbers", []Float8{FromInt(-3), FromInt(7), One(), FromInt(5)}, FromInt(7)},
+		{"negative numbers", []Float8{FromInt(-3), FromInt(-7)}, FromInt(-3)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ReduceSlice(tt.s, NegativeInfinity, Max)
+			if result != tt.expected {
+				t.Errorf("ReduceSlice(%v, -Inf, Max) = %v, want %v", tt.s, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("fold order", func(t *testing.T) {
+		// Subtraction is not associative, so this checks the left-to-right order.
+		result := ReduceSlice([]Float8{FromInt(3), FromInt(2)}, FromInt(10), Sub)
+		if result != FromInt(5) {
+			t.Errorf("ReduceSlice([3 2], 10, Sub) = %v, want 5", result)
+		}
+	})
+}
+
+func TestAlmostEqual(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     Float8
+		tol      Float8
+		expected bool
+	}{
+		{"identical", One(), One(), PositiveZero, true},
+		{"within tolerance", ToFloat8(1.0), ToFloat8(1.125), ToFloat8(0.125), true},
+		{"outside tolerance", ToFloat8(1.0), ToFloat8(1.25), ToFloat8(0.125), false},
+		{"symmetric", ToFloat8(1.25), ToFloat8(1.0), ToFloat8(0.25), true},
+		{"signed zeros", PositiveZero, NegativeZero, PositiveZero, true},
+		{"NaN", NaN, NaN, MaxValue, false},
+		{"NaN and number", NaN, One(), MaxValue, false},
+		{"same infinity", PositiveInfinity, PositiveInfinity, PositiveZero, true},
+		{"opposite infinities", PositiveInfinity, NegativeInfinity, PositiveInfinity, false},
+		{"infinity and max", PositiveInfinity, MaxValue, PositiveInfinity, false},
+		{"0.1 + 0.2", Add(ToFloat8(0.1), ToFloat8(0.2)), ToFloat8(0.3125), ToFloat8(0.01), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AlmostEqual(tt.a, tt.b, tt.tol); got != tt.expected {
+				t.Errorf("AlmostEqual(%v, %v, %v) = %v, want %v", tt.a, tt.b, tt.tol, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAlmostEqualULP(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     Float8
+		ulps     int
+		expected bool
+	}{
+		{"identical", One(), One(), 0, true},
+		{"one ulp", One(), 0x39, 1, true},
+		{"two ulps", One(), 0x3A, 1, false},
+		{"two ulps allowed", 0x3A, One(), 2, true},
+		{"signed zeros", PositiveZero, NegativeZero, 0, true},
+		{"across zero", SmallestPositive, SignMask | SmallestPositive, 2, true},
+		{"across infinity encoding", 0x77, 0x79, 1, true},
+		{"max and infinity", MaxValue, PositiveInfinity, 1, true},
+		{"NaN", NaN, NaN, 10, false},
+		{"negative ulps", One(), 0x39, -1, false},
+		{"imprecise sum", Add(ToFloat8(0.1), ToFloat8(0.2)), ToFloat8(0.3125), 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AlmostEqualULP(tt.a, tt.b, tt.ulps); got != tt.expected {
+				t.Errorf("AlmostEqualULP(%v, %v, %d) = %v, want %v", tt.a, tt.b, tt.ulps, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWhere(t *testing.T) {
+	a := []Float8{One(), NaN, PositiveInfinity, NegativeZero}
+	b := []Float8{FromInt(-1), 0xFF, NegativeInfinity, PositiveZero}
+
+	tests := []struct {
+		name     string
+		cond     []bool
+		expected []Float8
+	}{
+		{"all true", []bool{true, true, true, true}, a},
+		{"all false", []bool{false, false, false, false}, b},
+		{"mixed", []bool{true, false, true, false}, []Float8{One(), 0xFF, PositiveInfinity, PositiveZero}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Where(tt.cond, a, b)
+			for i := range result {
+				// Compare bit patterns so NaN encodings are checked too
+				if result[i].Bits() != tt.expected[i].Bits() {
+					t.Errorf("At index %d: expected 0x%02x, got 0x%02x", i, tt.expected[i].Bits(), result[i].Bits())
+				}
+			}
+		})
+	}
+
+	t.Run("panic on length mismatch", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected panic for slice length mismatch, but got none")
+			}
+		}()
+		_ = Where([]bool{true}, a, b)
+		t.Error("Expected panic but function completed successfully")
+	})
+}
+
+func TestWhereScalar(t *testing.T) {
+	cond := []bool{true, false, false, true}
+	result := WhereScalar(cond, NaN, NegativeInfinity)
+	expected := []Float8{NaN, NegativeInfinity, NegativeInfinity, NaN}
+	for i := range result {
+		if result[i].Bits() != expected[i].Bits() {
+			t.Errorf("At index %d: expected 0x%02x, got 0x%02x", i, expected[i].Bits(), result[i].Bits())
+		}
+	}
+
+	if got := WhereScalar(nil, One(), PositiveZero); len(got) != 0 {
+		t.Errorf("WhereScalar(nil) = %v, want empty", got)
+	}
+}
+
+func TestSanitizeSlice(t *testing.T) {
+	s := []Float8{One(), NaN, PositiveInfinity, NegativeInfinity, 0xFF, NegativeZero, MaxValue, SmallestPositive}
+	expected := []Float8{One(), PositiveZero, MaxValue, MinValue, PositiveZero, NegativeZero, MaxValue, SmallestPositive}
+
+	result := SanitizeSlice(s, PositiveZero, MaxValue, MinValue)
+	if !slices.Equal(result, expected) {
+		t.Errorf("SanitizeSlice() = %x, want %x", SliceAsBytes(result), SliceAsBytes(expected))
+	}
+	if s[1] != NaN {
+		t.Error("SanitizeSlice modified its input")
+	}
+
+	SanitizeSliceInPlace(s, PositiveZero, MaxValue, MinValue)
+	if !slices.Equal(s, expected) {
+		t.Errorf("SanitizeSliceInPlace() left %x, want %x", SliceAsBytes(s), SliceAsBytes(expected))
+	}
+}
+
+func TestReplaceNaN(t *testing.T) {
+	s := []Float8{NaN, One(), 0xFF, PositiveInfinity, NegativeZero}
+	ReplaceNaN(s, FromInt(-1))
+
+	expected := []Float8{FromInt(-1), One(), FromInt(-1), PositiveInfinity, NegativeZero}
+	if !slices.Equal(s, expected) {
+		t.Errorf("ReplaceNaN() left %x, want %x", SliceAsBytes(s), SliceAsBytes(expected))
+	}
+}
+
+func TestNaNPropagation(t *testing.T) {
+	ops := []struct {
+		name string
+		fn   func(a, b Float8) Float8
+	}{
+		{"Add", Add},
+		{"Sub", Sub},
+		{"Mul", Mul},
+		{"Div", Div},
+		{"Min", Min},
+		{"Max", Max},
+	}
+	tests := []struct {
+		name     string
+		a, b     Float8
+		expected Float8
+	}{
+		{"NaN first", NaN, One(), NaN},
+		{"NegativeNaN first", NegativeNaN, One(), NegativeNaN},
+		{"NegativeNaN second", One(), NegativeNaN, NegativeNaN},
+		{"both NaN", NegativeNaN, NaN, NegativeNaN},
+		{"NegativeNaN with infinity", PositiveInfinity, NegativeNaN, NegativeNaN},
+	}
+
+	for _, mode := range []struct {
+		name   string
+		enable func()
+	}{
+		{"algorithmic", DisableFastArithmetic},
+		{"lookup", EnableFastArithmetic},
+	} {
+		t.Run(mode.name, func(t *testing.T) {
+			mode.enable()
+			defer DisableFastArithmetic()
+			for _, op := range ops {
+				for _, tt := range tests {
+					if got := op.fn(tt.a, tt.b); got != tt.expected {
+						t.Errorf("%s(0x%02x, 0x%02x) = 0x%02x, want 0x%02x (%s)", op.name, uint8(tt.a), uint8(tt.b), uint8(got), uint8(tt.expected), tt.name)
+					}
+				}
+			}
+		})
+	}
+
+	// Invalid operations still produce the canonical NaN
+	if got := Sub(PositiveInfinity, PositiveInfinity); got != NaN {
+		t.Errorf("Sub(+Inf, +Inf) = 0x%02x, want 0x%02x", uint8(got), uint8(NaN))
+	}
+}
+
+func TestScalarOps(t *testing.T) {
+	s := []Float8{One(), FromInt(2), FromInt(3)}
+	ops := []struct {
+		name     string
+		fn       func([]Float8, Float8) []Float8
+		inPlace  func([]Float8, Float8)
+		scalar   Float8
+		expected []Float8
+	}{
+		{"AddScalar", AddScalar, AddScalarInPlace, FromInt(10), []Float8{FromInt(11), FromInt(12), FromInt(13)}},
+		{"SubScalar", SubScalar, SubScalarInPlace, One(), []Float8{PositiveZero, One(), FromInt(2)}},
+		{"MulScalar", MulScalar, MulScalarInPlace, FromInt(-2), []Float8{FromInt(-2), FromInt(-4), FromInt(-6)}},
+		{"DivScalar", DivScalar, DivScalarInPlace, FromInt(2), []Float8{ToFloat8(0.5), One(), ToFloat8(1.5)}},
+	}
+
+	for _, op := range ops {
+		t.Run(op.name, func(t *testing.T) {
+			result := op.fn(s, op.scalar)
+			inPlace := slices.Clone(s)
+			op.inPlace(inPlace, op.scalar)
+			for i := range op.expected {
+				if result[i] != op.expected[i] {
+					t.Errorf("%s(%v, %v)[%d] = %v, want %v", op.name, s, op.scalar, i, result[i], op.expected[i])
+				}
+				if inPlace[i] != op.expected[i] {
+					t.Errorf("%sInPlace(%v, %v)[%d] = %v, want %v", op.name, s, op.scalar, i, inPlace[i], op.expected[i])
+				}
+			}
+			if s[0] != One() {
+				t.Errorf("%s modified its input", op.name)
+			}
+
+			for _, v := range op.fn(s, NaN) {
+				if !v.IsNaN() {
+					t.Errorf("%s(%v, NaN) element = %v, want NaN", op.name, s, v)
+				}
+			}
+			if got := op.fn(nil, One()); len(got) != 0 {
+				t.Errorf("%s(nil, 1) = %v, want empty", op.name, got)
+			}
+		})
+	}
+
+	infTests := []struct {
+		name     string
+		got      []Float8
+		expected Float8
+	}{
+		{"AddScalar +Inf", AddScalar(s, PositiveInfinity), PositiveInfinity},
+		{"SubScalar +Inf", SubScalar(s, PositiveInfinity), NegativeInfinity},
+		{"MulScalar -Inf", MulScalar(s, NegativeInfinity), NegativeInfinity},
+		{"DivScalar +Inf", DivScalar(s, PositiveInfinity), PositiveZero},
+		{"DivScalar +0", DivScalar(s, PositiveZero), PositiveInfinity},
+	}
+	for _, tt := range infTests {
+		for i, v := range tt.got {
+			if v != tt.expected {
+				t.Errorf("%s[%d] = %v, want %v", tt.name, i, v, tt.expected)
+			}
+		}
+	}
+}
+
+func TestBroadcast(t *testing.T) {
+	v := []Float8{One(), FromInt(2), FromInt(3)}
+	bias := []Float8{FromInt(10)}
+
+	tests := []struct {
+		name     string
+		fn       func(a, b []Float8) ([]Float8, error)
+		a, b     []Float8
+		expected []Float8
+	}{
+		{"add equal lengths", AddBroadcast, v, v, []Float8{FromInt(2), FromInt(4), FromInt(6)}},
+		{"add scalar second", AddBroadcast, v, bias, []Float8{FromInt(11), FromInt(12), FromInt(13)}},
+		{"add scalar first", AddBroadcast, bias, v, []Float8{FromInt(11), FromInt(12), FromInt(13)}},
+		{"add both scalar", AddBroadcast, bias, bias, []Float8{FromInt(20)}},
+		{"add scalar to empty", AddBroadcast, bias, []Float8{}, []Float8{}},
+		{"mul scalar second", MulBroadcast, v, []Float8{FromInt(-2)}, []Float8{FromInt(-2), FromInt(-4), FromInt(-6)}},
+		{"mul scalar first", MulBroadcast, []Float8{ToFloat8(0.5)}, v, []Float8{ToFloat8(0.5), One(), ToFloat8(1.5)}},
+		{"mul equal lengths", MulBroadcast, v, v, []Float8{One(), FromInt(4), FromInt(9)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.fn(tt.a, tt.b)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(result) != len(tt.expected) {
+				t.Fatalf("got %v, want %v", result, tt.expected)
+			}
+			for i := range tt.expected {
+				if result[i] != tt.expected[i] {
+					t.Errorf("result[%d] = %v, want %v", i, result[i], tt.expected[i])
+				}
+			}
+		})
+	}
+
+	for _, fn := range []func(a, b []Float8) ([]Float8, error){AddBroadcast, MulBroadcast} {
+		result, err := fn(v, []Float8{One(), One()})
+		if err == nil || result != nil {
+			t.Errorf("broadcast of lengths 3 and 2 = %v, %v, want error", result, err)
+			continue
+		}
+		var ferr *Float8Error
+		if !errors.As(err, &ferr) || ferr.Op != "broadcast" {
+			t.Errorf("broadcast error = %v, want *Float8Error with Op \"broadcast\"", err)
+		}
+	}
+}
+
+func TestAffine(t *testing.T) {
+	s := []Float8{FromInt(-3), ToFloat8(0.5), ToFloat8(1.125), FromInt(20), NegativeZero}
+	scale, bias := ToFloat8(1.125), ToFloat8(-0.75)
+
+	got := Affine(s, scale, bias)
+	for i, x := range s {
+		want := ToFloat8(float32(float64(scale.ToFloat32())*float64(x.ToFloat32()) + float64(bias.ToFloat32())))
+		if got[i] != want {
+			t.Errorf("Affine element %d: scale*%v + bias = %v, want %v", i, x, got[i], want)
+		}
+	}
+
+	scales := []Float8{One(), FromInt(-2), ToFloat8(1.125), ToFloat8(0.25), FromInt(4)}
+	biases := []Float8{PositiveZero, One(), FromInt(-1), FromInt(8), ToFloat8(-0.5)}
+	got = AffineVec(s, scales, biases)
+	for i, x := range s {
+		want := ToFloat8(float32(float64(scales[i].ToFloat32())*float64(x.ToFloat32()) + float64(biases[i].ToFloat32())))
+		if got[i] != want {
+			t.Errorf("AffineVec element %d: %v*%v + %v = %v, want %v", i, scales[i], x, biases[i], got[i], want)
+		}
+	}
+
+	// The 1.125*1.125 - 1 element rounds once to 0.28125, not to 0.25.
+	if got[2] != ToFloat8(0.28125) {
+		t.Errorf("AffineVec rounded twice: got %v, want 0.28125", got[2])
+	}
+
+	if got := Affine(nil, scale, bias); len(got) != 0 {
+		t.Errorf("Affine(nil) = %v, want empty", got)
+	}
+
+	t.Run("length mismatch panics", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected panic but function completed successfully")
+			}
+		}()
+		AffineVec(s, scales, biases[:2])
+	})
+}
+
+func TestMinNMaxN(t *testing.T) {
+	tests := []struct {
+		name     string
+		vals     []Float8
+		min, max Float8
+	}{
+		{"empty", nil, PositiveInfinity, NegativeInfinity},
+		{"single", []Float8{FromInt(-2)}, FromInt(-2), FromInt(-2)},
+		{"three", []Float8{One(), FromInt(3), FromInt(2)}, One(), FromInt(3)},
+		{"infinities", []Float8{NegativeInfinity, One(), PositiveInfinity}, NegativeInfinity, PositiveInfinity},
+		{"NaN propagates", []Float8{One(), NaN, FromInt(3)}, NaN, NaN},
+		{"negative NaN propagates", []Float8{One(), NegativeNaN, NaN}, NegativeNaN, NegativeNaN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MinN(tt.vals...); got != tt.min {
+				t.Errorf("MinN(%v) = %v, want %v", tt.vals, got, tt.min)
+			}
+			if got := MaxN(tt.vals...); got != tt.max {
+				t.Errorf("MaxN(%v) = %v, want %v", tt.vals, got, tt.max)
+			}
+		})
+	}
+
+	if got := MaxN(One(), FromInt(3), FromInt(2)); got != FromInt(3) {
+		t.Errorf("MaxN(1, 3, 2) = %v, want 3", got)
+	}
+}
@@ -146,3 +146,65 @@ func BenchmarkDiv(b *testing.B) {
 		DisableFastArithmetic()
 	})
 }
+
+// BenchmarkToSlice8Large compares the unrolled ToSlice8 with the
+// element-at-a-time reference loop on a 1M-element tensor.
+func BenchmarkToSlice8Large(b *testing.B) {
+	f32s := make([]float32, 1<<20)
+	for i := range f32s {
+		// Activation-like values spread over [-4, 4)
+		f32s[i] = float32(i%2000-1000) / 250
+	}
+
+	b.Run("Reference", func(b *testing.B) {
+		b.SetBytes(int64(len(f32s)) * 4)
+		for i := 0; i < b.N; i++ {
+			_ = toSlice8Reference(f32s)
+		}
+	})
+	b.Run("ToSlice8", func(b *testing.B) {
+		b.SetBytes(int64(len(f32s)) * 4)
+		for i := 0; i < b.N; i++ {
+			_ = ToSlice8(f32s)
+		}
+	})
+}
+
+// BenchmarkSliceConversionInto compares the allocating batch conversions with
+// the variants that write into a caller-provided buffer.
+func BenchmarkSliceConversionInto(b *testing.B) {
+	f32s := make([]float32, 1000)
+	for i := range f32s {
+		f32s[i] = float32(i) * 0.1
+	}
+	f8s := ToSlice8(f32s)
+
+	b.Run("ToSlice8", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = ToSlice8(f32s)
+		}
+	})
+	b.Run("ToSlice8Into", func(b *testing.B) {
+		dst := make([]Float8, len(f32s))
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = ToSlice8Into(dst, f32s)
+		}
+	})
+	b.Run("ToSlice32", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = ToSlice32(f8s)
+		}
+	})
+	b.Run("ToSlice32Into", func(b *testing.B) {
+		dst := make([]float32, len(f8s))
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = ToSlice32Into(dst, f8s)
+		}
+	})
+}
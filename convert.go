@@ -2,12 +2,19 @@ package float8
 
 import (
 	"math"
+	"math/big"
+	"sort"
+	"sync/atomic"
 	"unsafe"
 )
 
 // Global conversion mode (can be changed for different behavior)
 var DefaultConversionMode = ModeDefault
 
+// FlushToZero makes conversions flush subnormal results to signed zero,
+// emulating hardware that does not support gradual underflow
+var FlushToZero = false
+
 // ToFloat8 converts a float32 value to Float8 format using the default conversion mode.
 //
 // This is a convenience function that calls ToFloat8WithMode with DefaultConversionMode.
@@ -22,6 +29,7 @@ var DefaultConversionMode = ModeDefault
 //
 // For finite numbers, the conversion may lose precision or result in overflow/underflow.
 // The default mode handles these cases by saturating to the maximum/minimum representable values.
+// Values below the smallest normal become subnormals unless FlushToZero is set.
 func ToFloat8(f32 float32) Float8 {
 	result, _ := ToFloat8WithMode(f32, DefaultConversionMode)
 	return result
@@ -45,8 +53,17 @@ func ToFloat8(f32 float32) Float8 {
 //  3. Round the mantissa to 3 bits (plus implicit leading bit)
 //  4. Handle overflow/underflow according to the conversion mode
 //
+// Results below the smallest normal magnitude (2^-6) are encoded as subnormals,
+// or flushed to signed zero when FlushToZero is enabled. A value that flushes or
+// rounds to zero is reported as an underflow in strict mode.
+//
 // Returns the converted Float8 value and an error if the conversion fails in strict mode.
 func ToFloat8WithMode(f32 float32, mode ConversionMode) (Float8, error) {
+	return toFloat8(f32, mode, FlushToZero)
+}
+
+// toFloat8 implements ToFloat8WithMode with an explicit flush-to-zero setting
+func toFloat8(f32 float32, mode ConversionMode, flush bool) (Float8, error) {
 	// Handle special cases first
 	if f32 == 0.0 {
 		// Check the sign bit to distinguish between +0.0 and -0.0
@@ -104,8 +121,26 @@ func ToFloat8WithMode(f32 float32, mode ConversionMode) (Float8, error) {
 		return PositiveInfinity, nil
 	}
 
-	// Check for underflow
-	if exp8 < ExponentMin {
+	// Values below the smallest normal exponent become subnormals
+	// (exponent field 0000, no implicit leading bit) or underflow to zero
+	if exp8 <= 0 {
+		// Shift the full significand, including its implicit leading 1,
+		// into the 3-bit subnormal mantissa and round like the normal path
+		var sub uint32
+		if shift := uint32(1-exp8) + (23 - MantissaLen); shift < 32 {
+			sig := mant | 1<<23
+			sub = sig >> shift
+			if (sig>>(shift-1))&1 != 0 {
+				sub++
+			}
+		}
+
+		// A mantissa that rounds up to 1<<MantissaLen carries into the
+		// smallest normal encoding, which is never flushed
+		if sub != 0 && (sub >= 1<<MantissaLen || !flush) {
+			return Float8((sign << 7) | sub), nil
+		}
+
 		if mode == ModeStrict {
 			return 0, &Float8Error{
 				Op:    "convert",
@@ -146,11 +181,201 @@ func ToFloat8WithMode(f32 float32, mode ConversionMode) (Float8, error) {
 		}
 	}
 
+	// The all-1s exponent reserves mantissa 000 for infinity and 111 for NaN,
+	// so 256 and 480 are not representable. Values that rounded to 480 lie
+	// beyond MaxValue plus half an ulp and overflow; values that rounded to 256
+	// go to the nearer of their finite neighbors 240 (0x77) and 288 (0x79).
+	if exp8 == ExponentMax {
+		switch mant8 {
+		case MantissaMask:
+			if mode == ModeStrict {
+				return 0, &Float8Error{
+					Op:    "convert",
+					Value: f32,
+					Msg:   "overflow after rounding",
+				}
+			}
+			if sign != 0 {
+				return NegativeInfinity, nil
+			}
+			return PositiveInfinity, nil
+		case 0:
+			if math.Float32bits(f32)&0x7FFFFFFF < math.Float32bits(264) {
+				return Float8((sign << 7) | 0x77), nil
+			}
+			mant8 = 1
+		}
+	}
+
 	// Combine components into Float8
 	result := Float8((sign << 7) | (uint32(exp8) << MantissaLen) | mant8)
 	return result, nil
 }
 
+// Nearest returns the Float8 value closest to f32 together with the signed
+// quantization residual f32 - value.ToFloat32().
+//
+// Rounding, including ties, follows ToFloat8. Unlike ToFloat8, finite inputs
+// beyond the Float8 range saturate to MaxValue or MinValue rather than
+// overflowing to infinity, so the residual reports the saturation gap.
+//
+// Special cases are:
+//
+//	Nearest(±Inf) = ±Inf, 0
+//	Nearest(NaN) = NaN, NaN
+func Nearest(f32 float32) (Float8, float32) {
+	if math.IsInf(float64(f32), 0) {
+		return ToFloat8(f32), 0
+	}
+	value := ToFloat8(f32)
+	if value.IsNaN() {
+		return NaN, f32
+	}
+	value = value.ClampToFinite()
+	return value, f32 - value.ToFloat32()
+}
+
+// Float32Values returns the exact float32 value of every Float8 bit pattern,
+// indexed by the pattern. Both NaN encodings map to a float32 NaN. The result
+// is a copy, so callers may modify it freely.
+func Float32Values() [256]float32 {
+	if table := conversionTable.Load(); table != nil {
+		return *table
+	}
+	return *buildConversionTable()
+}
+
+// NearestBits returns the bit pattern of the Float8 value nearest to f32,
+// found by binary search over the finite values of Float32Values. Ties round
+// away from zero and magnitudes of 464 or more round to ±Inf, so NearestBits
+// matches ToFloat8 with the default settings. It ignores FlushToZero and
+// DefaultConversionMode, preserves the sign of zero, and returns the NaN
+// pattern 0x7F for NaN.
+func NearestBits(f32 float32) uint8 {
+	if math.IsNaN(float64(f32)) {
+		return uint8(NaN)
+	}
+	var sign uint8
+	if math.Signbit(float64(f32)) {
+		sign = SignMask
+		f32 = -f32
+	}
+
+	// Search the finite magnitudes 0x00-0x7E, skipping the infinity encoding
+	// 0x78, for the first one whose midpoint with its successor lies above
+	// f32, as FromRat does. Midpoints are exact in float32.
+	const count = int(MaxValue)
+	magnitude := func(i int) Float8 {
+		if i >= int(PositiveInfinity) {
+			return Float8(i + 1)
+		}
+		return Float8(i)
+	}
+	i := sort.Search(count, func(i int) bool {
+		lo, hi := magnitude(i).ToFloat32(), float32(480)
+		if i < count-1 {
+			hi = magnitude(i + 1).ToFloat32()
+		}
+		return f32 < (lo+hi)/2
+	})
+	if i == count {
+		return sign | uint8(PositiveInfinity)
+	}
+	return sign | uint8(magnitude(i))
+}
+
+// ToRat returns the exact value of f as a rational number. Every finite
+// Float8 is a dyadic rational m/2^k, so no precision is lost. ToRat returns
+// nil if f is infinite or NaN; both zeros map to 0.
+func (f Float8) ToRat() *big.Rat {
+	if !f.IsFinite() {
+		return nil
+	}
+	return new(big.Rat).SetFloat64(f.ToFloat64())
+}
+
+// FromRat returns the Float8 value nearest to r, rounding ties away from zero
+// and magnitudes of 464 or more (halfway past MaxValue) to ±Inf, the same
+// results ToFloat8 gives for float32 inputs. Unlike converting through
+// r.Float64, the rounding decision is made on r exactly, so it is never
+// affected by double rounding. FromRat ignores FlushToZero and
+// DefaultConversionMode. It returns NaN if r is nil and PositiveZero if r is 0.
+func FromRat(r *big.Rat) Float8 {
+	if r == nil {
+		return NaN
+	}
+	if r.Sign() == 0 {
+		return PositiveZero
+	}
+	mag := new(big.Rat).Abs(r)
+	var sign Float8
+	if r.Sign() < 0 {
+		sign = SignMask
+	}
+
+	// The finite magnitudes in increasing order are the encodings 0x00-0x7E
+	// without the infinity encoding 0x78. Find the first one whose upper
+	// rounding boundary, the midpoint with its successor, lies above mag;
+	// above MaxValue the successor is 480, one step further at its exponent.
+	const count = int(MaxValue)
+	magnitude := func(i int) Float8 {
+		if i >= int(PositiveInfinity) {
+			return Float8(i + 1)
+		}
+		return Float8(i)
+	}
+	midpoint := new(big.Rat)
+	i := sort.Search(count, func(i int) bool {
+		lo, hi := magnitude(i).ToFloat64(), 480.0
+		if i < count-1 {
+			hi = magnitude(i + 1).ToFloat64()
+		}
+		// Midpoints of Float8 values are exactly representable in float64
+		midpoint.SetFloat64((lo + hi) / 2)
+		return mag.Cmp(midpoint) < 0
+	})
+	if i == count {
+		return sign | PositiveInfinity
+	}
+	return sign | magnitude(i)
+}
+
+// BigFloat returns the exact value of f as a big.Float, preserving the sign
+// of zeros and infinities. Since big.Float has no NaN, BigFloat returns nil
+// if f is NaN.
+func (f Float8) BigFloat() *big.Float {
+	if f.IsNaN() {
+		return nil
+	}
+	return new(big.Float).SetFloat64(f.ToFloat64())
+}
+
+// FromBigFloat returns the Float8 value nearest to b, rounding ties away from
+// zero as FromRat does. Finite values beyond the Float8 range saturate to
+// MaxValue or MinValue instead of overflowing, while infinite b maps to ±Inf
+// and zeros keep their sign. FromBigFloat returns NaN if b is nil, the
+// counterpart of BigFloat for NaN.
+func FromBigFloat(b *big.Float) Float8 {
+	if b == nil {
+		return NaN
+	}
+	if b.IsInf() {
+		if b.Signbit() {
+			return NegativeInfinity
+		}
+		return PositiveInfinity
+	}
+	if b.Sign() == 0 {
+		if b.Signbit() {
+			return NegativeZero
+		}
+		return PositiveZero
+	}
+
+	r, _ := b.Rat(nil)
+	return FromRat(r).ClampToFinite()
+}
+
 // ToFloat32 converts a Float8 value to float32.
 //
 // This conversion is always exact since Float8 is a subset of float32.
@@ -163,8 +388,8 @@ func ToFloat8WithMode(f32 float32, mode ConversionMode) (Float8, error) {
 // algorithmic conversion for other values.
 func (f Float8) ToFloat32() float32 {
 	// Use lookup table for fast conversion if available
-	if conversionTable != nil {
-		return conversionTable[f]
+	if table := conversionTable.Load(); table != nil {
+		return table[f]
 	}
 	return f.toFloat32Algorithmic()
 }
@@ -194,6 +419,15 @@ func (f Float8) toFloat32Algorithmic() float32 {
 	exp8 := (uint32(f) >> MantissaLen) & 0x0F
 	mant8 := uint32(f) & MantissaMask
 
+	// Subnormals have no implicit leading bit: value = 0.mmm × 2^(1-bias)
+	if exp8 == 0 {
+		result := float32(mant8) / (1 << (ExponentBias - 1 + MantissaLen))
+		if sign != 0 {
+			return -result
+		}
+		return result
+	}
+
 	// Convert exponent from float8 bias to float32 bias
 	exp32 := exp8 - ExponentBias + Float32Bias
 
@@ -220,7 +454,7 @@ func (f Float8) toFloat32Algorithmic() float32 {
 //   - A non-nil empty slice if the input slice is empty
 //   - A new slice containing the converted Float8 values
 //
-// Note: This function preserves negative zero by checking the sign bit of zero values.
+// Note: Each element gives the same result as ToFloat8, including negative zero.
 // For large slices, consider using a pool of []Float8 to reduce allocations.
 func ToSlice8(f32s []float32) []Float8 {
 	if f32s == nil {
@@ -231,18 +465,92 @@ func ToSlice8(f32s []float32) []Float8 {
 	}
 
 	result := make([]Float8, len(f32s))
+	convertSlice8(result, f32s)
+	return result
+}
 
-	// Convert each element, preserving negative zero
-	for i := 0; i < len(f32s); i++ {
-		// Special handling for negative zero
-		if f32s[i] == 0 && math.Signbit(float64(f32s[i])) {
-			result[i] = NegativeZero
-		} else {
-			result[i] = ToFloat8(f32s[i])
+// convertSlice8 converts src into dst, which must be at least as long, with
+// the same results as calling ToFloat8 on each element. DefaultConversionMode
+// and FlushToZero are read once, and elements are converted four at a time
+// through the inlined normalFloat8 fast path, falling back to toFloat8 only
+// for the elements it cannot handle.
+func convertSlice8(dst []Float8, src []float32) {
+	mode, flush := DefaultConversionMode, FlushToZero
+	dst = dst[:len(src)]
+
+	i := 0
+	for ; i+4 <= len(src); i += 4 {
+		s, d := src[i:i+4:i+4], dst[i:i+4:i+4]
+		var ok [4]bool
+		d[0], ok[0] = normalFloat8(s[0])
+		d[1], ok[1] = normalFloat8(s[1])
+		d[2], ok[2] = normalFloat8(s[2])
+		d[3], ok[3] = normalFloat8(s[3])
+		if !(ok[0] && ok[1] && ok[2] && ok[3]) {
+			for j := range ok {
+				if !ok[j] {
+					d[j], _ = toFloat8(s[j], mode, flush)
+				}
+			}
 		}
 	}
+	for ; i < len(src); i++ {
+		var ok bool
+		if dst[i], ok = normalFloat8(src[i]); !ok {
+			dst[i], _ = toFloat8(src[i], mode, flush)
+		}
+	}
+}
 
-	return result
+// normalFloat8 converts f32 with a few bit operations if its magnitude lies
+// in [2^-6, 128), where the result is normal in every mode and rounding cannot
+// carry into the all-1s exponent. It reports false for any other input,
+// which must go through toFloat8.
+func normalFloat8(f32 float32) (Float8, bool) {
+	bits := math.Float32bits(f32)
+	// exp8 = exp - Float32Bias + ExponentBias must lie in [1, ExponentMax-2]
+	exp := (bits >> 23) & 0xFF
+	if exp-(Float32Bias-ExponentBias+1) >= ExponentMax-2 {
+		return 0, false
+	}
+	mag := (exp-(Float32Bias-ExponentBias))<<MantissaLen | (bits>>(23-MantissaLen))&MantissaMask
+	// Rounding half away from zero may carry into the exponent
+	mag += (bits >> (23 - MantissaLen - 1)) & 1
+	return Float8((bits>>31)<<7 | mag), true
+}
+
+// ToSlice8Strict converts a slice of float32 to Float8 in strict mode, reporting
+// every element that cannot be represented.
+//
+// Each element is converted with ModeStrict. Elements that overflow, underflow, or
+// are NaN are recorded in a ConversionErrors value together with their index; the
+// corresponding output element holds the saturated ModeDefault result so the
+// returned slice is always fully populated.
+//
+// Returns:
+//   - nil, nil if the input slice is nil
+//   - The converted slice and a nil error if every element converted exactly
+//   - The converted slice and a ConversionErrors error otherwise
+func ToSlice8Strict(f32s []float32) ([]Float8, error) {
+	if f32s == nil {
+		return nil, nil
+	}
+
+	result := make([]Float8, len(f32s))
+	var errs ConversionErrors
+	for i, v := range f32s {
+		f8, err := ToFloat8WithMode(v, ModeStrict)
+		if err != nil {
+			f8, _ = ToFloat8WithMode(v, ModeDefault)
+			errs = append(errs, ConversionError{Index: i, Err: err.(*Float8Error)})
+		}
+		result[i] = f8
+	}
+
+	if len(errs) > 0 {
+		return result, errs
+	}
+	return result, nil
 }
 
 // ToSlice32 converts a slice of Float8 to float32 with optimized performance.
@@ -278,6 +586,54 @@ func ToSlice32(f8s []Float8) []float32 {
 	return result
 }
 
+// ToSlice8Into converts src to Float8, writing into dst without allocating.
+//
+// It converts min(len(dst), len(src)) elements, preserving negative zero exactly
+// like ToSlice8, and returns the number of elements written. Elements of dst
+// beyond that count are left untouched.
+func ToSlice8Into(dst []Float8, src []float32) int {
+	n := min(len(dst), len(src))
+	convertSlice8(dst[:n], src[:n])
+	return n
+}
+
+// ToSlice32Into converts src to float32, writing into dst without allocating.
+//
+// It converts min(len(dst), len(src)) elements and returns the number of
+// elements written. Elements of dst beyond that count are left untouched.
+func ToSlice32Into(dst []float32, src []Float8) int {
+	n := min(len(dst), len(src))
+	for i := 0; i < n; i++ {
+		dst[i] = src[i].ToFloat32()
+	}
+	return n
+}
+
+// SliceAsBytes returns a []byte view of s without copying.
+//
+// The returned slice shares its backing array with s and has the same length
+// and capacity, so writes through either view are visible in the other.
+// Returns nil if s is nil.
+func SliceAsBytes(s []Float8) []byte {
+	if s == nil {
+		return nil
+	}
+	return unsafe.Slice((*byte)(unsafe.SliceData(s)), cap(s))[:len(s)]
+}
+
+// BytesAsSlice returns a []Float8 view of b without copying, interpreting
+// each byte as a Float8 bit pattern.
+//
+// The returned slice shares its backing array with b and has the same length
+// and capacity, so writes through either view are visible in the other.
+// Returns nil if b is nil.
+func BytesAsSlice(b []byte) []Float8 {
+	if b == nil {
+		return nil
+	}
+	return unsafe.Slice((*Float8)(unsafe.SliceData(b)), cap(b))[:len(b)]
+}
+
 // Parse converts a string to Float8
 func Parse(s string) (Float8, error) {
 	// This would implement string parsing - simplified for now
@@ -285,27 +641,41 @@ func Parse(s string) (Float8, error) {
 	return PositiveZero, &Float8Error{Op: "parse", Msg: "not implemented"}
 }
 
-// Lookup table for fast conversion (loaded lazily)
-var conversionTable []float32
+// Lookup table for fast conversion (loaded lazily). The pointer is swapped
+// atomically so ToFloat32 can read it without locking; writers hold tablesMu.
+var conversionTable atomic.Pointer[[256]float32]
 
-// initConversionTable initializes the conversion lookup table
+// initConversionTable initializes the conversion lookup table.
+// Callers must hold tablesMu or otherwise exclude concurrent writers.
 func initConversionTable() {
-	if conversionTable != nil {
+	if conversionTable.Load() != nil {
 		return
 	}
 
-	conversionTable = make([]float32, 256)
+	conversionTable.Store(buildConversionTable())
+}
+
+// buildConversionTable computes a 256-entry Float8 to float32 table
+func buildConversionTable() *[256]float32 {
+	table := new([256]float32)
 	for i := 0; i < 256; i++ {
-		conversionTable[i] = Float8(i).toFloat32Algorithmic()
+		table[i] = Float8(i).toFloat32Algorithmic()
 	}
+	return table
 }
 
-// EnableFastConversion enables lookup table for ToFloat32 conversion
+// EnableFastConversion enables lookup table for ToFloat32 conversion.
+// It is safe to call concurrently with conversions on other goroutines.
 func EnableFastConversion() {
+	tablesMu.Lock()
+	defer tablesMu.Unlock()
 	initConversionTable()
 }
 
-// DisableFastConversion disables lookup table and uses algorithmic conversion
+// DisableFastConversion disables lookup table and uses algorithmic conversion.
+// It is safe to call concurrently with conversions on other goroutines.
 func DisableFastConversion() {
-	conversionTable = nil
+	tablesMu.Lock()
+	defer tablesMu.Unlock()
+	conversionTable.Store(nil)
 }
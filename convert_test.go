@@ -1,7 +1,9 @@
 package float8
 
 import (
+	"errors"
 	"math"
+	"math/big"
 	"strings"
 	"testing"
 )
@@ -162,7 +164,7 @@ func TestConversionTable(t *testing.T) {
 	DisableFastConversion()
 
 	// Test that the table is nil initially
-	if conversionTable != nil {
+	if conversionTable.Load() != nil {
 		t.Error("conversionTable should be nil initially")
 	}
 
@@ -170,12 +172,13 @@ func TestConversionTable(t *testing.T) {
 	t.Run("EnableFastConversion", func(t *testing.T) {
 		EnableFastConversion()
 
-		if conversionTable == nil {
-			t.Error("conversionTable should be initialized after EnableFastConversion")
+		table := conversionTable.Load()
+		if table == nil {
+			t.Fatal("conversionTable should be initialized after EnableFastConversion")
 		}
 
-		if len(conversionTable) != 256 {
-			t.Errorf("conversionTable length = %d, want 256", len(conversionTable))
+		if len(table) != 256 {
+			t.Errorf("conversionTable length = %d, want 256", len(table))
 		}
 
 		// Test a few values to ensure the table is populated correctly
@@ -198,7 +201,7 @@ func TestConversionTable(t *testing.T) {
 			if tv.skip {
 				continue // Skip values that are approximations
 			}
-			got := conversionTable[tv.input]
+			got := table[tv.input]
 			if !(math.IsNaN(float64(got)) && math.IsNaN(float64(tv.output))) && got != tv.output {
 				t.Errorf("conversionTable[0x%02X] = %v, want %v", tv.input, got, tv.output)
 			}
@@ -209,7 +212,7 @@ func TestConversionTable(t *testing.T) {
 	t.Run("DisableFastConversion", func(t *testing.T) {
 		DisableFastConversion()
 
-		if conversionTable != nil {
+		if conversionTable.Load() != nil {
 			t.Error("conversionTable should be nil after DisableFastConversion")
 		}
 	})
@@ -335,3 +338,601 @@ func TestToFloat8WithModeEdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestFlushToZero(t *testing.T) {
+	origConfig := DefaultConfig()
+	defer Configure(origConfig)
+
+	tests := []struct {
+		name  string
+		input float32
+		want  Float8 // Expected encoding with gradual underflow
+		ftz   Float8 // Expected encoding with FlushToZero enabled
+	}{
+		{"smallest subnormal", 0.001953125, 0x01, PositiveZero},
+		{"negative smallest subnormal", -0.001953125, 0x81, NegativeZero},
+		{"mid subnormal", 0.0078125, 0x04, PositiveZero},
+		{"largest subnormal", 0.013671875, 0x07, PositiveZero},
+		{"rounds up to smallest normal", 0.0152, 0x08, 0x08},
+		{"smallest normal", 0.015625, 0x08, 0x08},
+		{"below half smallest subnormal", 0.0009, PositiveZero, PositiveZero},
+	}
+
+	for _, flush := range []bool{false, true} {
+		config := DefaultConfig()
+		config.FlushToZero = flush
+		Configure(config)
+
+		for _, tt := range tests {
+			want := tt.want
+			if flush {
+				want = tt.ftz
+			}
+			if got := ToFloat8(tt.input); got != want {
+				t.Errorf("FlushToZero=%v: ToFloat8(%g) = 0x%02x, want 0x%02x", flush, tt.input, got, want)
+			}
+		}
+	}
+
+	t.Run("strict mode reports flushed subnormal", func(t *testing.T) {
+		config := DefaultConfig()
+		config.FlushToZero = true
+		Configure(config)
+
+		_, err := ToFloat8WithMode(0.001953125, ModeStrict)
+		if err == nil || !strings.Contains(err.Error(), "underflow") {
+			t.Errorf("ToFloat8WithMode(0.001953125, ModeStrict) error = %v, want underflow", err)
+		}
+	})
+}
+
+func TestSubnormalDecoding(t *testing.T) {
+	for bits := 0x01; bits <= 0x07; bits++ {
+		want := float32(bits) / 512
+		if got := Float8(bits).toFloat32Algorithmic(); got != want {
+			t.Errorf("Float8(0x%02x).ToFloat32() = %g, want %g", bits, got, want)
+		}
+		if got := Float8(bits | SignMask).toFloat32Algorithmic(); got != -want {
+			t.Errorf("Float8(0x%02x).ToFloat32() = %g, want %g", bits|SignMask, got, -want)
+		}
+	}
+}
+
+// TestExponentAllOnesRounding covers inputs that round into the all-1s exponent,
+// where mantissa 000 encodes infinity and 111 encodes NaN.
+func TestExponentAllOnesRounding(t *testing.T) {
+	tests := []struct {
+		name  string
+		input float32
+		want  Float8
+	}{
+		{"largest below reserved", 240, 0x77},
+		{"rounds up to 256", 250, 0x77},
+		{"exactly 256", 256, 0x77},
+		{"below midpoint", 263, 0x77},
+		{"midpoint", 264, 0x79},
+		{"above midpoint", 270, 0x79},
+		{"negative 256", -256, 0xF7},
+		{"negative above midpoint", -270, 0xF9},
+		{"max value", 448, MaxValue},
+		{"below overflow threshold", 463, MaxValue},
+		{"overflow threshold", 464, PositiveInfinity},
+		{"rounds to 480", 480, PositiveInfinity},
+		{"negative rounds to 480", -480, NegativeInfinity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToFloat8WithMode(tt.input, ModeDefault)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ToFloat8(%g) = 0x%02x, want 0x%02x", tt.input, uint8(got), uint8(tt.want))
+			}
+			if got.IsNaN() {
+				t.Errorf("ToFloat8(%g) produced NaN from a finite input", tt.input)
+			}
+		})
+	}
+
+	if _, err := ToFloat8WithMode(480, ModeStrict); err == nil {
+		t.Error("ToFloat8WithMode(480, ModeStrict) expected overflow error")
+	}
+}
+
+// toSlice8Reference converts f32s one element at a time with ToFloat8, as
+// ToSlice8 did before it was unrolled.
+func toSlice8Reference(f32s []float32) []Float8 {
+	if f32s == nil {
+		return nil
+	}
+	result := make([]Float8, len(f32s))
+	for i, v := range f32s {
+		result[i] = ToFloat8(v)
+	}
+	return result
+}
+
+func TestToSlice8MatchesReference(t *testing.T) {
+	var input []float32
+	for _, v := range AllValues() {
+		f32 := v.ToFloat32()
+		input = append(input, f32, math.Nextafter32(f32, 0), f32*1.0625, f32*0.97)
+		if next := NextAfter(v, PositiveInfinity); v.IsFinite() && next.IsFinite() {
+			mid := (f32 + next.ToFloat32()) / 2
+			input = append(input, mid, -mid, math.Nextafter32(mid, 0))
+		}
+	}
+	input = append(input, float32(math.Copysign(0, -1)), 1e-10, -1e-10, 1e10, -1e10, 463.9, 464)
+
+	defer func(mode ConversionMode, flush bool) {
+		DefaultConversionMode, FlushToZero = mode, flush
+	}(DefaultConversionMode, FlushToZero)
+
+	for _, mode := range []ConversionMode{ModeDefault, ModeStrict, ModeFast} {
+		for _, flush := range []bool{false, true} {
+			DefaultConversionMode, FlushToZero = mode, flush
+			// Cover every remainder of the four-element unrolling
+			for _, n := range []int{len(input), len(input) - 1, len(input) - 2, len(input) - 3, 3, 1} {
+				got, want := ToSlice8(input[:n]), toSlice8Reference(input[:n])
+				if !equalBits(got, want) {
+					t.Fatalf("mode %v, flush %v, n %d: ToSlice8 differs from the reference", mode, flush, n)
+				}
+				dst := make([]Float8, n)
+				if ToSlice8Into(dst, input[:n]) != n || !equalBits(dst, want) {
+					t.Fatalf("mode %v, flush %v, n %d: ToSlice8Into differs from the reference", mode, flush, n)
+				}
+			}
+		}
+	}
+
+	if got := ToSlice8([]float32{float32(math.Copysign(0, -1))}); got[0] != NegativeZero {
+		t.Errorf("ToSlice8([-0]) = %v, want [-0]", got)
+	}
+}
+
+func TestToSlice8Strict(t *testing.T) {
+	input := []float32{1.0, 1e10, 0.5, 1e-10, float32(math.NaN()), -2.0}
+
+	got, err := ToSlice8Strict(input)
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	var convErrs ConversionErrors
+	if !errors.As(err, &convErrs) {
+		t.Fatalf("error type = %T, want ConversionErrors", err)
+	}
+
+	wantIndices := []int{1, 3, 4}
+	if len(convErrs) != len(wantIndices) {
+		t.Fatalf("got %d element errors, want %d: %v", len(convErrs), len(wantIndices), err)
+	}
+	for i, want := range wantIndices {
+		if convErrs[i].Index != want {
+			t.Errorf("error %d index = %d, want %d", i, convErrs[i].Index, want)
+		}
+	}
+	if !strings.Contains(convErrs[0].Err.Msg, "overflow") {
+		t.Errorf("index 1 error = %q, want overflow", convErrs[0].Err.Msg)
+	}
+	if !strings.Contains(convErrs[1].Err.Msg, "underflow") {
+		t.Errorf("index 3 error = %q, want underflow", convErrs[1].Err.Msg)
+	}
+	if !errors.Is(err, ErrNaN) {
+		t.Error("errors.Is(err, ErrNaN) = false, want true")
+	}
+	for _, want := range []string{"index 1", "index 3", "index 4", "1e+10"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error message %q does not contain %q", err.Error(), want)
+		}
+	}
+
+	// Successful elements are populated; failed ones hold the saturated value
+	want := []Float8{ToFloat8(1.0), PositiveInfinity, ToFloat8(0.5), PositiveZero, NaN, ToFloat8(-2.0)}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("ToSlice8Strict()[%d] = 0x%02x, want 0x%02x", i, uint8(got[i]), uint8(want[i]))
+		}
+	}
+
+	t.Run("all representable", func(t *testing.T) {
+		got, err := ToSlice8Strict([]float32{1, 2, 0.25})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(got) != 3 || got[1] != ToFloat8(2) {
+			t.Errorf("ToSlice8Strict() = %v", got)
+		}
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		got, err := ToSlice8Strict(nil)
+		if got != nil || err != nil {
+			t.Errorf("ToSlice8Strict(nil) = %v, %v, want nil, nil", got, err)
+		}
+	})
+}
+
+func TestToSlice8Into(t *testing.T) {
+	src := []float32{1.0, float32(math.Copysign(0, -1)), -2.0, 1e10, 0.5}
+	want := ToSlice8(src)
+
+	t.Run("full length", func(t *testing.T) {
+		dst := make([]Float8, len(src))
+		if n := ToSlice8Into(dst, src); n != len(src) {
+			t.Errorf("ToSlice8Into() = %d, want %d", n, len(src))
+		}
+		for i := range want {
+			if dst[i] != want[i] {
+				t.Errorf("dst[%d] = 0x%02x, want 0x%02x", i, uint8(dst[i]), uint8(want[i]))
+			}
+		}
+		if dst[1] != NegativeZero {
+			t.Errorf("negative zero not preserved: got 0x%02x", uint8(dst[1]))
+		}
+	})
+
+	t.Run("short dst", func(t *testing.T) {
+		dst := make([]Float8, 2)
+		if n := ToSlice8Into(dst, src); n != 2 {
+			t.Errorf("ToSlice8Into() = %d, want 2", n)
+		}
+		if dst[0] != want[0] || dst[1] != want[1] {
+			t.Errorf("dst = %v, want %v", dst, want[:2])
+		}
+	})
+
+	t.Run("long dst", func(t *testing.T) {
+		dst := []Float8{0, 0, 0, 0, 0, NaN, NaN}
+		if n := ToSlice8Into(dst, src); n != len(src) {
+			t.Errorf("ToSlice8Into() = %d, want %d", n, len(src))
+		}
+		if dst[5] != NaN || dst[6] != NaN {
+			t.Error("elements beyond the converted count were modified")
+		}
+	})
+}
+
+func TestToSlice32Into(t *testing.T) {
+	src := []Float8{One(), NegativeZero, ToFloat8(-2.0), PositiveInfinity}
+	want := ToSlice32(src)
+
+	dst := make([]float32, 3)
+	if n := ToSlice32Into(dst, src); n != 3 {
+		t.Errorf("ToSlice32Into() = %d, want 3", n)
+	}
+	for i := range dst {
+		if dst[i] != want[i] || math.Signbit(float64(dst[i])) != math.Signbit(float64(want[i])) {
+			t.Errorf("dst[%d] = %g, want %g", i, dst[i], want[i])
+		}
+	}
+
+	if n := ToSlice32Into(nil, src); n != 0 {
+		t.Errorf("ToSlice32Into(nil, src) = %d, want 0", n)
+	}
+}
+
+func TestNearest(t *testing.T) {
+	t.Run("residual bounded by half a ULP", func(t *testing.T) {
+		maxVal := MaxValue.ToFloat32()
+		for f32 := -maxVal; f32 <= maxVal; f32 += 0.37 {
+			value, residual := Nearest(f32)
+			if got := f32 - value.ToFloat32(); got != residual {
+				t.Fatalf("Nearest(%g) residual = %g, want %g", f32, residual, got)
+			}
+
+			// The gap to the neighbor on the residual's side bounds the error
+			towards := PositiveInfinity
+			if residual < 0 {
+				towards = NegativeInfinity
+			}
+			neighbor := NextAfter(value, towards)
+			halfULP := float32(math.Abs(float64(neighbor.ToFloat32()-value.ToFloat32()))) / 2
+			if float32(math.Abs(float64(residual))) > halfULP {
+				t.Errorf("Nearest(%g) = %v with residual %g, exceeds half ULP %g", f32, value, residual, halfULP)
+			}
+		}
+	})
+
+	tests := []struct {
+		name     string
+		input    float32
+		value    Float8
+		residual float32
+	}{
+		{"exact", 1.5, ToFloat8(1.5), 0},
+		{"signed residual", 1.1, ToFloat8(1.125), float32(1.1) - 1.125},
+		{"overflow saturates", 1000, MaxValue, 1000 - 448},
+		{"negative overflow saturates", -500, MinValue, -500 + 448},
+		{"positive infinity", float32(math.Inf(1)), PositiveInfinity, 0},
+		{"negative infinity", float32(math.Inf(-1)), NegativeInfinity, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, residual := Nearest(tt.input)
+			if value != tt.value || residual != tt.residual {
+				t.Errorf("Nearest(%g) = %v, %g, want %v, %g", tt.input, value, residual, tt.value, tt.residual)
+			}
+		})
+	}
+
+	t.Run("NaN", func(t *testing.T) {
+		value, residual := Nearest(float32(math.NaN()))
+		if !value.IsNaN() || !math.IsNaN(float64(residual)) {
+			t.Errorf("Nearest(NaN) = %v, %g, want NaN, NaN", value, residual)
+		}
+	})
+}
+
+func TestSliceAsBytes(t *testing.T) {
+	s := make([]Float8, 3, 8)
+	s[0], s[1], s[2] = One(), NegativeZero, NaN
+
+	b := SliceAsBytes(s)
+	if len(b) != len(s) || cap(b) != cap(s) {
+		t.Fatalf("SliceAsBytes() len/cap = %d/%d, want %d/%d", len(b), cap(b), len(s), cap(s))
+	}
+	for i := range s {
+		if b[i] != uint8(s[i]) {
+			t.Errorf("b[%d] = 0x%02x, want 0x%02x", i, b[i], uint8(s[i]))
+		}
+	}
+
+	// Writes through either view are visible in the other
+	b[0] = uint8(MaxValue)
+	if s[0] != MaxValue {
+		t.Errorf("s[0] = 0x%02x after writing through the byte view, want 0x%02x", uint8(s[0]), uint8(MaxValue))
+	}
+	s[2] = PositiveInfinity
+	if b[2] != uint8(PositiveInfinity) {
+		t.Errorf("b[2] = 0x%02x after writing through the Float8 view, want 0x%02x", b[2], uint8(PositiveInfinity))
+	}
+
+	// Capacity is shared, so growing within it stays aliased
+	b = append(b, 0x40)
+	if s[:4][3] != FromBits(0x40) {
+		t.Errorf("appended byte not visible in the Float8 backing array")
+	}
+
+	if SliceAsBytes(nil) != nil {
+		t.Error("SliceAsBytes(nil) is not nil")
+	}
+	if got := SliceAsBytes([]Float8{}); got == nil || len(got) != 0 {
+		t.Errorf("SliceAsBytes(empty) = %v, want an empty non-nil slice", got)
+	}
+}
+
+func TestBytesAsSlice(t *testing.T) {
+	b := []byte{0x38, 0x80, 0x7F, 0x78}
+
+	s := BytesAsSlice(b)
+	if len(s) != len(b) || cap(s) != cap(b) {
+		t.Fatalf("BytesAsSlice() len/cap = %d/%d, want %d/%d", len(s), cap(s), len(b), cap(b))
+	}
+	expected := []Float8{One(), NegativeZero, NaN, PositiveInfinity}
+	for i := range s {
+		if s[i] != expected[i] {
+			t.Errorf("s[%d] = 0x%02x, want 0x%02x", i, uint8(s[i]), uint8(expected[i]))
+		}
+	}
+
+	s[1] = ToFloat8(2)
+	if b[1] != uint8(ToFloat8(2)) {
+		t.Errorf("b[1] = 0x%02x after writing through the Float8 view, want 0x%02x", b[1], uint8(ToFloat8(2)))
+	}
+
+	if BytesAsSlice(nil) != nil {
+		t.Error("BytesAsSlice(nil) is not nil")
+	}
+}
+
+func TestFloat32Values(t *testing.T) {
+	for _, fast := range []bool{false, true} {
+		if fast {
+			EnableFastConversion()
+		}
+		values := Float32Values()
+		if values[0x38] != 1.0 {
+			t.Errorf("Float32Values()[0x38] = %v, want 1", values[0x38])
+		}
+		for i, v := range values {
+			want := Float8(i).toFloat32Algorithmic()
+			if math.Float32bits(v) != math.Float32bits(want) && !(v != v && want != want) {
+				t.Errorf("Float32Values()[0x%02X] = %v, want %v", i, v, want)
+			}
+		}
+		values[0x38] = 0
+		if Float32Values()[0x38] != 1.0 {
+			t.Error("Float32Values returned a shared table")
+		}
+	}
+	DisableFastConversion()
+}
+
+func TestNearestBits(t *testing.T) {
+	inputs := []float32{
+		0, float32(math.Copysign(0, -1)), 1, -1, 0.3, -2.7, 1.0625, 0.0009765625,
+		0.001, 448, 463.9, 464, -500, 1e-10,
+		float32(math.Inf(1)), float32(math.Inf(-1)),
+	}
+	for _, v := range AllValues() {
+		if v.IsNaN() {
+			continue
+		}
+		inputs = append(inputs, v.ToFloat32())
+		if next := NextAfter(v, PositiveInfinity); next.IsFinite() && v.IsFinite() {
+			lo, hi := v.ToFloat32(), next.ToFloat32()
+			mid := (lo + hi) / 2
+			inputs = append(inputs, mid, math.Nextafter32(mid, lo), math.Nextafter32(mid, hi))
+		}
+	}
+
+	for _, f32 := range inputs {
+		for _, x := range []float32{f32, -f32} {
+			if got, want := NearestBits(x), uint8(ToFloat8(x)); got != want {
+				t.Errorf("NearestBits(%v) = 0x%02X, want 0x%02X", x, got, want)
+			}
+		}
+	}
+	if got := NearestBits(float32(math.NaN())); got != uint8(NaN) {
+		t.Errorf("NearestBits(NaN) = 0x%02X, want 0x%02X", got, uint8(NaN))
+	}
+}
+
+func TestToRat(t *testing.T) {
+	// 0.1 rounds to 0.1015625 = 13/128: mantissa 1.101₂ at exponent 2^-4
+	if got, want := ToFloat8(0.1).ToRat(), big.NewRat(13, 128); got.Cmp(want) != 0 {
+		t.Errorf("ToFloat8(0.1).ToRat() = %v, want %v", got, want)
+	}
+
+	tests := []struct {
+		name string
+		f    Float8
+		want *big.Rat
+	}{
+		{"one", One(), big.NewRat(1, 1)},
+		{"negative", ToFloat8(-1.5), big.NewRat(-3, 2)},
+		{"max value", MaxValue, big.NewRat(448, 1)},
+		{"smallest subnormal", SmallestPositive, big.NewRat(1, 512)},
+		{"negative zero", NegativeZero, new(big.Rat)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.ToRat(); got.Cmp(tt.want) != 0 {
+				t.Errorf("%v.ToRat() = %v, want %v", tt.f, got, tt.want)
+			}
+		})
+	}
+
+	for _, f := range []Float8{PositiveInfinity, NegativeInfinity, NaN, NegativeNaN} {
+		if got := f.ToRat(); got != nil {
+			t.Errorf("0x%02x.ToRat() = %v, want nil", uint8(f), got)
+		}
+	}
+}
+
+func TestFromRat(t *testing.T) {
+	tests := []struct {
+		name string
+		r    *big.Rat
+		want Float8
+	}{
+		{"zero", new(big.Rat), PositiveZero},
+		{"exact", big.NewRat(3, 2), ToFloat8(1.5)},
+		{"one tenth", big.NewRat(1, 10), ToFloat8(0.1)},
+		{"one third", big.NewRat(1, 3), ToFloat8(1.0 / 3)},
+		{"negative", big.NewRat(-7, 3), ToFloat8(-7.0 / 3)},
+		{"tie away from zero", big.NewRat(17, 16), ToFloat8(1.125)},
+		{"negative tie away from zero", big.NewRat(-17, 16), ToFloat8(-1.125)},
+		{"just below a tie", new(big.Rat).Sub(big.NewRat(17, 16), big.NewRat(1, 1<<60)), One()},
+		{"tie across the infinity encoding", big.NewRat(264, 1), Float8(0x79)},
+		{"below overflow", big.NewRat(4639, 10), MaxValue},
+		{"overflow", big.NewRat(464, 1), PositiveInfinity},
+		{"negative overflow", big.NewRat(-1000, 1), NegativeInfinity},
+		{"subnormal tie", big.NewRat(1, 1024), SmallestPositive},
+		{"underflow", big.NewRat(1, 1025), PositiveZero},
+		{"nil", nil, NaN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromRat(tt.r); got != tt.want {
+				t.Errorf("FromRat(%v) = %v (0x%02x), want %v (0x%02x)", tt.r, got, uint8(got), tt.want, uint8(tt.want))
+			}
+		})
+	}
+
+	// FromRat inverts ToRat for every finite value and agrees with ToFloat8
+	// on the midpoints between neighbors
+	values := AllFiniteValues()
+	for i, f := range values {
+		if got := FromRat(f.ToRat()); got != f && !(f.IsZero() && got.IsZero()) {
+			t.Errorf("FromRat(%v.ToRat()) = 0x%02x, want 0x%02x", f, uint8(got), uint8(f))
+		}
+		if i > 0 {
+			mid := (values[i-1].ToFloat64() + f.ToFloat64()) / 2
+			if got, want := FromRat(new(big.Rat).SetFloat64(mid)), ToFloat8(float32(mid)); got != want && !(got.IsZero() && want.IsZero()) {
+				t.Errorf("FromRat(%v) = 0x%02x, ToFloat8 = 0x%02x", mid, uint8(got), uint8(want))
+			}
+		}
+	}
+}
+
+func TestBigFloat(t *testing.T) {
+	tests := []struct {
+		name string
+		f    Float8
+		want float64
+	}{
+		{"one", One(), 1},
+		{"negative", ToFloat8(-2.5), -2.5},
+		{"max value", MaxValue, 448},
+		{"smallest subnormal", SmallestPositive, 1.0 / 512},
+		{"infinity", PositiveInfinity, math.Inf(1)},
+		{"negative infinity", NegativeInfinity, math.Inf(-1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.f.BigFloat()
+			if got.Cmp(big.NewFloat(tt.want)) != 0 {
+				t.Errorf("%v.BigFloat() = %v, want %v", tt.f, got, tt.want)
+			}
+		})
+	}
+
+	if got := NegativeZero.BigFloat(); got.Sign() != 0 || !got.Signbit() {
+		t.Errorf("NegativeZero.BigFloat() = %v, want -0", got)
+	}
+	for _, f := range []Float8{NaN, NegativeNaN} {
+		if got := f.BigFloat(); got != nil {
+			t.Errorf("0x%02x.BigFloat() = %v, want nil", uint8(f), got)
+		}
+	}
+}
+
+func TestFromBigFloat(t *testing.T) {
+	tests := []struct {
+		name string
+		b    *big.Float
+		want Float8
+	}{
+		{"exact", big.NewFloat(1.5), ToFloat8(1.5)},
+		{"rounds", big.NewFloat(0.1), ToFloat8(0.1)},
+		{"saturates", big.NewFloat(1000), MaxValue},
+		{"saturates negative", big.NewFloat(-1e30), MinValue},
+		{"huge exponent saturates", new(big.Float).SetMantExp(big.NewFloat(1), 100000), MaxValue},
+		{"tiny exponent underflows", new(big.Float).SetMantExp(big.NewFloat(-1), -100000), NegativeZero},
+		{"positive zero", new(big.Float), PositiveZero},
+		{"negative zero", big.NewFloat(math.Copysign(0, -1)), NegativeZero},
+		{"infinity", new(big.Float).SetInf(false), PositiveInfinity},
+		{"negative infinity", new(big.Float).SetInf(true), NegativeInfinity},
+		{"nil", nil, NaN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromBigFloat(tt.b); got != tt.want {
+				t.Errorf("FromBigFloat(%v) = %v (0x%02x), want %v (0x%02x)", tt.b, got, uint8(got), tt.want, uint8(tt.want))
+			}
+		})
+	}
+
+	// A value computed in high precision quantizes like the float32 path
+	for _, f := range AllFiniteValues() {
+		if got := FromBigFloat(f.BigFloat()); got != f {
+			t.Errorf("FromBigFloat(%v.BigFloat()) = 0x%02x, want 0x%02x", f, uint8(got), uint8(f))
+		}
+	}
+	third := new(big.Float).SetPrec(200).Quo(big.NewFloat(1), big.NewFloat(3))
+	for _, k := range []float64{1, 2, 5, 10, 100} {
+		x := new(big.Float).SetPrec(200).Mul(third, big.NewFloat(k))
+		if got, want := FromBigFloat(x), ToFloat8(float32(k/3)); got != want {
+			t.Errorf("FromBigFloat(%v/3) = %v, want %v", k, got, want)
+		}
+	}
+}
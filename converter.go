@@ -0,0 +1,225 @@
+package float8
+
+import (
+	"math"
+	"sync/atomic"
+)
+
+// Converter holds a configuration together with its own lookup tables.
+//
+// The package-level functions (ToFloat8, Add, Mul, ...) share global modes and
+// tables that Configure mutates. A Converter encapsulates that state instead, so
+// several configurations can be used side by side, for example one Converter with
+// fast tables and another in strict mode, without touching the globals.
+//
+// A Converter's configuration is immutable after construction, and a Converter
+// is safe for concurrent use. Its only mutable state is the exception flags
+// described at Flags, which are updated atomically.
+type Converter struct {
+	conversionMode ConversionMode
+	arithmeticMode ArithmeticMode
+	flushToZero    bool
+
+	conversionTable *[256]float32
+	arithTables     *arithmeticTables
+
+	flags atomic.Uint32
+}
+
+// Flags records the IEEE 754 exceptions raised by a Converter's conversions
+// and arithmetic, in the manner of C's fetestexcept. Flags are sticky: once
+// raised they stay set until ClearFlags, so a sequence of operations can be
+// checked at the end without aborting like ModeStrict.
+//
+// Converter.ToFloat8 and the Converter's Add, Sub, Mul, and Div raise flags;
+// the package-level functions do not.
+type Flags struct {
+	// Inexact is set when a result had to be rounded
+	Inexact bool
+	// Overflow is set when a finite result was too large and rounded to ±Inf
+	Overflow bool
+	// Underflow is set when a nonzero result smaller than the smallest normal
+	// magnitude, before rounding, was also inexact
+	Underflow bool
+	// Invalid is set when an operation on non-NaN operands produced NaN,
+	// such as 0/0, Inf-Inf, or 0*Inf
+	Invalid bool
+}
+
+// Bits of Converter.flags
+const (
+	flagInexact uint32 = 1 << iota
+	flagOverflow
+	flagUnderflow
+	flagInvalid
+)
+
+// GetFlags returns the exceptions raised since the Converter was created or
+// ClearFlags was last called.
+func (c *Converter) GetFlags() Flags {
+	bits := c.flags.Load()
+	return Flags{
+		Inexact:   bits&flagInexact != 0,
+		Overflow:  bits&flagOverflow != 0,
+		Underflow: bits&flagUnderflow != 0,
+		Invalid:   bits&flagInvalid != 0,
+	}
+}
+
+// ClearFlags resets all exception flags, like C's feclearexcept(FE_ALL_EXCEPT).
+func (c *Converter) ClearFlags() {
+	c.flags.Store(0)
+}
+
+// raise sets the flags for rounding the exact value exact to result.
+// nanOperand reports whether an input was NaN, in which case a NaN result is
+// propagated rather than invalid.
+func (c *Converter) raise(exact float64, result Float8, nanOperand bool) {
+	var bits uint32
+	switch {
+	case math.IsNaN(exact):
+		if !nanOperand {
+			bits = flagInvalid
+		}
+	case math.IsInf(exact, 0), float64(result.ToFloat32()) == exact:
+		// Exact results, including infinite operands, raise nothing
+	default:
+		bits = flagInexact
+		if result.IsInf() {
+			bits |= flagOverflow
+		} else if math.Abs(exact) < 1.0/(1<<(ExponentBias-1)) {
+			bits |= flagUnderflow
+		}
+	}
+	if bits != 0 {
+		c.flags.Or(bits)
+	}
+}
+
+// NewConverter creates a Converter from the given configuration.
+//
+// Lookup tables requested by the configuration are built eagerly and owned by
+// the Converter. A nil config is equivalent to DefaultConfig().
+func NewConverter(config *Config) *Converter {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	c := &Converter{
+		conversionMode: config.DefaultMode,
+		arithmeticMode: config.ArithmeticMode,
+		flushToZero:    config.FlushToZero,
+	}
+	if config.EnableFastConversion {
+		c.conversionTable = buildConversionTable()
+	}
+	if config.EnableFastArithmetic {
+		c.arithTables = buildArithmeticTables(c.flushToZero)
+	}
+	return c
+}
+
+// Config returns a copy of the configuration the Converter was created with
+func (c *Converter) Config() *Config {
+	return &Config{
+		EnableFastArithmetic: c.arithTables != nil,
+		EnableFastConversion: c.conversionTable != nil,
+		DefaultMode:          c.conversionMode,
+		ArithmeticMode:       c.arithmeticMode,
+		FlushToZero:          c.flushToZero,
+	}
+}
+
+// MemoryUsage returns the memory used by the Converter's lookup tables in bytes
+func (c *Converter) MemoryUsage() int {
+	var usage int
+	if c.conversionTable != nil {
+		usage += 256 * 4 // 256 float32 values
+	}
+	if c.arithTables != nil {
+		usage += 4 * 65536 // add, sub, mul, div tables of 65536 uint8 values
+	}
+	return usage
+}
+
+// ToFloat8 converts a float32 to Float8 using the Converter's conversion mode.
+//
+// An error is only returned in ModeStrict; see ToFloat8WithMode.
+//
+// It raises Inexact, Overflow, and Underflow in the Converter's flags as
+// appropriate; in ModeStrict the flags describe the saturated ModeDefault
+// result.
+func (c *Converter) ToFloat8(f32 float32) (Float8, error) {
+	result, err := toFloat8(f32, c.conversionMode, c.flushToZero)
+	rounded := result
+	if err != nil {
+		rounded, _ = toFloat8(f32, ModeDefault, c.flushToZero)
+	}
+	c.raise(float64(f32), rounded, true)
+	return result, err
+}
+
+// ToFloat32 converts a Float8 to float32, using the Converter's conversion table if it has one
+func (c *Converter) ToFloat32(f Float8) float32 {
+	if c.conversionTable != nil {
+		return c.conversionTable[f]
+	}
+	return f.toFloat32Algorithmic()
+}
+
+// useTables reports whether the Converter's arithmetic mode allows table lookups
+func (c *Converter) useTables() bool {
+	return c.arithmeticMode == ArithmeticAuto || c.arithmeticMode == ArithmeticLookup
+}
+
+// Add returns a+b using the Converter's arithmetic mode and tables,
+// raising the Converter's flags as appropriate
+func (c *Converter) Add(a, b Float8) Float8 {
+	var result Float8
+	if c.useTables() && c.arithTables != nil {
+		result = c.arithTables.add[uint16(a)<<8|uint16(b)]
+	} else {
+		result = addAlgorithmic(a, b, c.flushToZero)
+	}
+	c.raise(float64(a.ToFloat32())+float64(b.ToFloat32()), result, a.IsNaN() || b.IsNaN())
+	return result
+}
+
+// Sub returns a-b using the Converter's arithmetic mode and tables,
+// raising the Converter's flags as appropriate
+func (c *Converter) Sub(a, b Float8) Float8 {
+	var result Float8
+	if c.useTables() && c.arithTables != nil {
+		result = c.arithTables.sub[uint16(a)<<8|uint16(b)]
+	} else {
+		result = subAlgorithmic(a, b, c.flushToZero)
+	}
+	c.raise(float64(a.ToFloat32())-float64(b.ToFloat32()), result, a.IsNaN() || b.IsNaN())
+	return result
+}
+
+// Mul returns a*b using the Converter's arithmetic mode and tables,
+// raising the Converter's flags as appropriate
+func (c *Converter) Mul(a, b Float8) Float8 {
+	var result Float8
+	if c.useTables() && c.arithTables != nil {
+		result = c.arithTables.mul[uint16(a)<<8|uint16(b)]
+	} else {
+		result = mulAlgorithmic(a, b, c.flushToZero)
+	}
+	c.raise(float64(a.ToFloat32())*float64(b.ToFloat32()), result, a.IsNaN() || b.IsNaN())
+	return result
+}
+
+// Div returns a/b using the Converter's arithmetic mode and tables,
+// raising the Converter's flags as appropriate
+func (c *Converter) Div(a, b Float8) Float8 {
+	var result Float8
+	if c.useTables() && c.arithTables != nil {
+		result = c.arithTables.div[uint16(a)<<8|uint16(b)]
+	} else {
+		result = divAlgorithmic(a, b, c.flushToZero)
+	}
+	c.raise(float64(a.ToFloat32())/float64(b.ToFloat32()), result, a.IsNaN() || b.IsNaN())
+	return result
+}
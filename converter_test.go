@@ -0,0 +1,175 @@
+package float8
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNewConverter(t *testing.T) {
+	c := NewConverter(nil)
+	if got, want := *c.Config(), *DefaultConfig(); got != want {
+		t.Errorf("NewConverter(nil).Config() = %+v, want %+v", got, want)
+	}
+	if usage := c.MemoryUsage(); usage != 0 {
+		t.Errorf("NewConverter(nil).MemoryUsage() = %d, want 0", usage)
+	}
+
+	config := &Config{
+		EnableFastArithmetic: true,
+		EnableFastConversion: true,
+		DefaultMode:          ModeStrict,
+		ArithmeticMode:       ArithmeticLookup,
+		FlushToZero:          true,
+	}
+	c = NewConverter(config)
+	if got := *c.Config(); got != *config {
+		t.Errorf("NewConverter(config).Config() = %+v, want %+v", got, *config)
+	}
+	if usage, want := c.MemoryUsage(), 256*4+65536*4; usage != want {
+		t.Errorf("MemoryUsage() = %d, want %d", usage, want)
+	}
+}
+
+func TestConverterIndependence(t *testing.T) {
+	origConfig := DefaultConfig()
+	Configure(origConfig)
+	defer Configure(origConfig)
+
+	fast := NewConverter(&Config{EnableFastArithmetic: true, EnableFastConversion: true})
+	slow := NewConverter(&Config{EnableFastArithmetic: false, EnableFastConversion: false})
+
+	if fast.MemoryUsage() == 0 {
+		t.Error("fast converter should own lookup tables")
+	}
+	if slow.MemoryUsage() != 0 {
+		t.Error("slow converter should not own lookup tables")
+	}
+	if arithTables.Load() != nil || conversionTable.Load() != nil {
+		t.Error("creating converters must not enable the package-level tables")
+	}
+
+	ops := []struct {
+		name string
+		fast func(Float8, Float8) Float8
+		slow func(Float8, Float8) Float8
+		ref  func(Float8, Float8) Float8
+	}{
+		{"Add", fast.Add, slow.Add, Add},
+		{"Sub", fast.Sub, slow.Sub, Sub},
+		{"Mul", fast.Mul, slow.Mul, Mul},
+		{"Div", fast.Div, slow.Div, Div},
+	}
+
+	for _, op := range ops {
+		t.Run(op.name, func(t *testing.T) {
+			for a := 0; a < 256; a += 7 {
+				for b := 0; b < 256; b += 5 {
+					fa, fb := Float8(a), Float8(b)
+					want := op.ref(fa, fb)
+					for _, got := range []Float8{op.fast(fa, fb), op.slow(fa, fb)} {
+						if got != want && !(got.IsNaN() && want.IsNaN()) {
+							t.Errorf("%s(0x%02x, 0x%02x) = 0x%02x, want 0x%02x", op.name, a, b, uint8(got), uint8(want))
+						}
+					}
+				}
+			}
+		})
+	}
+
+	// Disabling the package tables leaves the converter's tables in place
+	EnableFastArithmetic()
+	DisableFastArithmetic()
+	if fast.arithTables == nil {
+		t.Error("DisableFastArithmetic must not affect a Converter's tables")
+	}
+}
+
+func TestConverterModes(t *testing.T) {
+	strict := NewConverter(&Config{DefaultMode: ModeStrict})
+	lenient := NewConverter(&Config{DefaultMode: ModeDefault})
+
+	if _, err := strict.ToFloat8(1e10); err == nil {
+		t.Error("strict converter should report overflow")
+	}
+	got, err := lenient.ToFloat8(1e10)
+	if err != nil || got != PositiveInfinity {
+		t.Errorf("lenient.ToFloat8(1e10) = %v, %v, want +Inf, nil", got, err)
+	}
+
+	ftz := NewConverter(&Config{FlushToZero: true})
+	if got, _ := ftz.ToFloat8(0.001953125); got != PositiveZero {
+		t.Errorf("ftz.ToFloat8(smallest subnormal) = 0x%02x, want 0x00", uint8(got))
+	}
+	if got, _ := lenient.ToFloat8(0.001953125); got != SmallestPositive {
+		t.Errorf("lenient.ToFloat8(smallest subnormal) = 0x%02x, want 0x01", uint8(got))
+	}
+	if got := ftz.Mul(SmallestPositive, One()); got != PositiveZero {
+		t.Errorf("ftz.Mul(subnormal, 1) = 0x%02x, want 0x00", uint8(got))
+	}
+	if got := ftz.ToFloat32(SmallestPositive); got != SmallestPositive.toFloat32Algorithmic() {
+		t.Errorf("ftz.ToFloat32(subnormal) = %g, want exact value", got)
+	}
+}
+
+func TestConverterFlags(t *testing.T) {
+	tests := []struct {
+		name string
+		op   func(c *Converter)
+		want Flags
+	}{
+		{"exact conversion", func(c *Converter) { c.ToFloat8(1.5) }, Flags{}},
+		{"rounding conversion", func(c *Converter) { c.ToFloat8(1.1) }, Flags{Inexact: true}},
+		{"overflowing conversion", func(c *Converter) { c.ToFloat8(1000) }, Flags{Inexact: true, Overflow: true}},
+		{"underflowing conversion", func(c *Converter) { c.ToFloat8(0.0001) }, Flags{Inexact: true, Underflow: true}},
+		{"exact subnormal", func(c *Converter) { c.ToFloat8(0.001953125) }, Flags{}},
+		{"NaN conversion", func(c *Converter) { c.ToFloat8(float32(math.NaN())) }, Flags{}},
+		{"infinite conversion", func(c *Converter) { c.ToFloat8(float32(math.Inf(-1))) }, Flags{}},
+		{"exact add", func(c *Converter) { c.Add(One(), FromInt(2)) }, Flags{}},
+		{"rounding add", func(c *Converter) { c.Add(FromInt(16), ToFloat8(0.5)) }, Flags{Inexact: true}},
+		{"overflowing mul", func(c *Converter) { c.Mul(MaxValue, FromInt(2)) }, Flags{Inexact: true, Overflow: true}},
+		{"rounding div", func(c *Converter) { c.Div(One(), FromInt(3)) }, Flags{Inexact: true}},
+		{"zero over zero", func(c *Converter) { c.Div(PositiveZero, PositiveZero) }, Flags{Invalid: true}},
+		{"infinity minus infinity", func(c *Converter) { c.Sub(PositiveInfinity, PositiveInfinity) }, Flags{Invalid: true}},
+		{"divide by zero", func(c *Converter) { c.Div(One(), PositiveZero) }, Flags{}},
+		{"NaN operand", func(c *Converter) { c.Add(NaN, One()) }, Flags{}},
+		{"sticky", func(c *Converter) {
+			c.ToFloat8(1.1)
+			c.Add(One(), One())
+			c.Div(PositiveZero, PositiveZero)
+		}, Flags{Inexact: true, Invalid: true}},
+	}
+
+	for _, config := range []*Config{
+		{},
+		{EnableFastArithmetic: true, DefaultMode: ModeStrict},
+	} {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				c := NewConverter(config)
+				tt.op(c)
+				if got := c.GetFlags(); got != tt.want {
+					t.Errorf("flags = %+v, want %+v", got, tt.want)
+				}
+			})
+		}
+	}
+
+	t.Run("ClearFlags", func(t *testing.T) {
+		c := NewConverter(nil)
+		c.Mul(MaxValue, MaxValue)
+		c.ClearFlags()
+		if got := c.GetFlags(); got != (Flags{}) {
+			t.Errorf("flags after ClearFlags = %+v, want none", got)
+		}
+	})
+
+	t.Run("flush to zero underflows", func(t *testing.T) {
+		c := NewConverter(&Config{FlushToZero: true})
+		if got, _ := c.ToFloat8(0.001953125); got != PositiveZero {
+			t.Fatalf("ToFloat8(2^-9) = %v, want +0", got)
+		}
+		if got, want := c.GetFlags(), (Flags{Inexact: true, Underflow: true}); got != want {
+			t.Errorf("flags = %+v, want %+v", got, want)
+		}
+	})
+}
@@ -0,0 +1,194 @@
+package float8
+
+import (
+	"encoding/binary"
+	"io"
+	"math/bits"
+)
+
+// Binary encoding of Float8 slices
+//
+// Each Float8 is stored as its single-byte bit pattern, so a slice encodes to
+// exactly len(s) bytes with no framing. Float8Slice.WriteTo adds a small
+// header in front of those bytes for self-describing streams.
+
+// MarshalSlice returns the bit patterns of s as a newly allocated byte slice.
+// Returns nil if s is nil.
+func MarshalSlice(s []Float8) []byte {
+	if s == nil {
+		return nil
+	}
+	data := make([]byte, len(s))
+	copy(data, SliceAsBytes(s))
+	return data
+}
+
+// UnmarshalSlice returns a newly allocated slice holding the Float8 values
+// encoded in data, one per byte. Returns nil if data is nil.
+func UnmarshalSlice(data []byte) []Float8 {
+	if data == nil {
+		return nil
+	}
+	s := make([]Float8, len(data))
+	copy(s, BytesAsSlice(data))
+	return s
+}
+
+// WriteSlice writes the bit patterns of s to w without copying them.
+// It returns the number of bytes written and any write error.
+func WriteSlice(w io.Writer, s []Float8) (int, error) {
+	return w.Write(SliceAsBytes(s))
+}
+
+// ReadSlice reads exactly n Float8 values from r.
+//
+// If r ends before n values are read, ReadSlice returns the values read so
+// far along with io.ErrUnexpectedEOF, or io.EOF if nothing was read.
+func ReadSlice(r io.Reader, n int) ([]Float8, error) {
+	if n < 0 {
+		return nil, &Float8Error{Op: "read", Msg: "negative length"}
+	}
+	data := make([]byte, n)
+	read, err := io.ReadFull(r, data)
+	return BytesAsSlice(data[:read]), err
+}
+
+// BitDiff returns the number of bit positions in which the encodings of a
+// and b differ, from 0 to 8. It compares bit patterns, not values, so
+// BitDiff(PositiveZero, NegativeZero) = 1 and BitDiff(NaN, NaN) = 0.
+func BitDiff(a, b Float8) int {
+	return bits.OnesCount8(uint8(a ^ b))
+}
+
+// HammingDistance returns the total number of differing bits between the
+// encodings of a and b, summing BitDiff over each pair of elements.
+// Panics if the slices have different lengths.
+func HammingDistance(a, b []Float8) int {
+	if len(a) != len(b) {
+		panic("float8: slice length mismatch")
+	}
+	distance := 0
+	for i := range a {
+		distance += BitDiff(a[i], b[i])
+	}
+	return distance
+}
+
+// Pack2 packs two Float8 values into a uint16, with hi in the upper byte and
+// lo in the lower byte.
+func Pack2(hi, lo Float8) uint16 {
+	return uint16(hi)<<8 | uint16(lo)
+}
+
+// Unpack2 splits a uint16 produced by Pack2 back into its two Float8 values.
+func Unpack2(u uint16) (hi, lo Float8) {
+	return Float8(u >> 8), Float8(u)
+}
+
+// PackSlice packs consecutive pairs of s into uint16 values using Pack2, so
+// s[2*i] is the upper byte of the i-th result and s[2*i+1] the lower byte.
+// If len(s) is odd, the final value is padded with PositiveZero in its lower
+// byte; pass len(s) to UnpackSlice to drop the padding again.
+// Returns nil if s is nil.
+func PackSlice(s []Float8) []uint16 {
+	if s == nil {
+		return nil
+	}
+	packed := make([]uint16, (len(s)+1)/2)
+	for i := range packed {
+		lo := PositiveZero
+		if 2*i+1 < len(s) {
+			lo = s[2*i+1]
+		}
+		packed[i] = Pack2(s[2*i], lo)
+	}
+	return packed
+}
+
+// UnpackSlice returns the first n Float8 values packed in u by PackSlice.
+// Panics if n is negative or greater than 2*len(u).
+func UnpackSlice(u []uint16, n int) []Float8 {
+	if n < 0 || n > 2*len(u) {
+		panic("float8: unpack length out of range")
+	}
+	s := make([]Float8, n)
+	for i := range s {
+		hi, lo := Unpack2(u[i/2])
+		if i%2 == 0 {
+			s[i] = hi
+		} else {
+			s[i] = lo
+		}
+	}
+	return s
+}
+
+// Stream header written by Float8Slice.WriteTo: the magic bytes, the
+// package's major version, and the number of values as a little-endian
+// uint64.
+const (
+	streamMagic      = "FP8S"
+	streamHeaderSize = len(streamMagic) + 1 + 8
+)
+
+// WriteTo writes s to w as a header followed by the bit patterns of s,
+// implementing io.WriterTo. The header records VersionMajor and len(s) so
+// that ReadSliceFrom can detect foreign and truncated data.
+// It returns the number of bytes written and any write error.
+func (s Float8Slice) WriteTo(w io.Writer) (int64, error) {
+	var header [streamHeaderSize]byte
+	copy(header[:], streamMagic)
+	header[len(streamMagic)] = VersionMajor
+	binary.LittleEndian.PutUint64(header[len(streamMagic)+1:], uint64(len(s)))
+
+	n, err := w.Write(header[:])
+	if err != nil {
+		return int64(n), err
+	}
+	m, err := WriteSlice(w, s)
+	return int64(n + m), err
+}
+
+// ReadFrom replaces the contents of s with a slice read from r in the format
+// written by WriteTo, implementing io.ReaderFrom. It returns the number of
+// bytes read and any error; on error s is left unchanged.
+func (s *Float8Slice) ReadFrom(r io.Reader) (int64, error) {
+	var header [streamHeaderSize]byte
+	n, err := io.ReadFull(r, header[:])
+	if err != nil {
+		return int64(n), err
+	}
+	if string(header[:len(streamMagic)]) != streamMagic {
+		return int64(n), &Float8Error{Op: "read", Msg: "invalid stream header"}
+	}
+	if header[len(streamMagic)] != VersionMajor {
+		return int64(n), &Float8Error{Op: "read", Value: float32(header[len(streamMagic)]), Msg: "unsupported stream version"}
+	}
+	length := binary.LittleEndian.Uint64(header[len(streamMagic)+1:])
+
+	// Read through a limited reader rather than allocating length bytes up
+	// front, so a corrupted length cannot force a huge allocation
+	data, err := io.ReadAll(io.LimitReader(r, int64(min(length, 1<<62))))
+	total := int64(n + len(data))
+	if err != nil {
+		return total, err
+	}
+	if uint64(len(data)) != length {
+		return total, io.ErrUnexpectedEOF
+	}
+	*s = BytesAsSlice(data)
+	return total, nil
+}
+
+// ReadSliceFrom reads a slice written by Float8Slice.WriteTo from r.
+//
+// It returns an error if the header is not a Float8 stream header, was
+// written by an incompatible major version, or if r ends before the
+// recorded number of values (io.ErrUnexpectedEOF).
+func ReadSliceFrom(r io.Reader) ([]Float8, error) {
+	var s Float8Slice
+	if _, err := s.ReadFrom(r); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
@@ -0,0 +1,299 @@
+package float8
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestMarshalSlice(t *testing.T) {
+	s := []Float8{One(), NegativeZero, NaN, MaxValue}
+
+	data := MarshalSlice(s)
+	if !bytes.Equal(data, []byte{0x38, 0x80, 0x7F, 0x7E}) {
+		t.Errorf("MarshalSlice(%v) = %x, want 38807f7e", s, data)
+	}
+
+	// The encoding is a copy, not a view
+	data[0] = 0
+	if s[0] != One() {
+		t.Error("MarshalSlice result aliases its input")
+	}
+
+	decoded := UnmarshalSlice([]byte{0x38, 0x80, 0x7F, 0x7E})
+	for i := range s {
+		if decoded[i] != s[i] {
+			t.Errorf("UnmarshalSlice()[%d] = 0x%02x, want 0x%02x", i, uint8(decoded[i]), uint8(s[i]))
+		}
+	}
+
+	if MarshalSlice(nil) != nil || UnmarshalSlice(nil) != nil {
+		t.Error("MarshalSlice(nil) or UnmarshalSlice(nil) is not nil")
+	}
+}
+
+func TestWriteReadSlice(t *testing.T) {
+	s := RandSlice8(rand.New(rand.NewPCG(1, 2)), 10000)
+
+	var buf bytes.Buffer
+	n, err := WriteSlice(&buf, s)
+	if err != nil || n != len(s) {
+		t.Fatalf("WriteSlice() = %d, %v, want %d, nil", n, err, len(s))
+	}
+
+	got, err := ReadSlice(&buf, len(s))
+	if err != nil {
+		t.Fatalf("ReadSlice() error = %v", err)
+	}
+	if len(got) != len(s) {
+		t.Fatalf("ReadSlice() length = %d, want %d", len(got), len(s))
+	}
+	for i := range s {
+		if got[i] != s[i] {
+			t.Fatalf("At index %d: got 0x%02x, want 0x%02x", i, uint8(got[i]), uint8(s[i]))
+		}
+	}
+}
+
+func TestReadSliceShort(t *testing.T) {
+	got, err := ReadSlice(bytes.NewReader([]byte{0x38, 0x40}), 4)
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("ReadSlice() error = %v, want io.ErrUnexpectedEOF", err)
+	}
+	if len(got) != 2 || got[0] != One() || got[1] != FromInt(2) {
+		t.Errorf("ReadSlice() = %v, want the two values read", got)
+	}
+
+	if _, err := ReadSlice(bytes.NewReader(nil), 1); !errors.Is(err, io.EOF) {
+		t.Errorf("ReadSlice(empty) error = %v, want io.EOF", err)
+	}
+
+	if _, err := ReadSlice(bytes.NewReader(nil), -1); err == nil {
+		t.Error("ReadSlice(r, -1) returned no error")
+	}
+}
+
+func TestPack2(t *testing.T) {
+	if got := Pack2(One(), NaN); got != 0x387F {
+		t.Errorf("Pack2(One, NaN) = 0x%04x, want 0x387f", got)
+	}
+
+	rng := rand.New(rand.NewPCG(1, 2))
+	for range 1000 {
+		hi, lo := Float8(rng.UintN(256)), Float8(rng.UintN(256))
+		gotHi, gotLo := Unpack2(Pack2(hi, lo))
+		if gotHi != hi || gotLo != lo {
+			t.Errorf("Unpack2(Pack2(0x%02x, 0x%02x)) = (0x%02x, 0x%02x)", uint8(hi), uint8(lo), uint8(gotHi), uint8(gotLo))
+		}
+	}
+}
+
+func TestPackSlice(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []Float8
+		expected []uint16
+	}{
+		{"nil", nil, nil},
+		{"empty", []Float8{}, []uint16{}},
+		{"single", []Float8{One()}, []uint16{0x3800}},
+		{"even", []Float8{One(), NegativeZero, MaxValue, NaN}, []uint16{0x3880, 0x7E7F}},
+		{"odd", []Float8{One(), NegativeZero, MaxValue}, []uint16{0x3880, 0x7E00}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			packed := PackSlice(tt.input)
+			if (packed == nil) != (tt.expected == nil) || len(packed) != len(tt.expected) {
+				t.Fatalf("PackSlice(%v) = %x, want %x", tt.input, packed, tt.expected)
+			}
+			for i := range packed {
+				if packed[i] != tt.expected[i] {
+					t.Errorf("PackSlice(%v)[%d] = 0x%04x, want 0x%04x", tt.input, i, packed[i], tt.expected[i])
+				}
+			}
+
+			unpacked := UnpackSlice(packed, len(tt.input))
+			if len(unpacked) != len(tt.input) {
+				t.Fatalf("UnpackSlice() length = %d, want %d", len(unpacked), len(tt.input))
+			}
+			for i := range unpacked {
+				if unpacked[i] != tt.input[i] {
+					t.Errorf("UnpackSlice()[%d] = 0x%02x, want 0x%02x", i, uint8(unpacked[i]), uint8(tt.input[i]))
+				}
+			}
+		})
+	}
+}
+
+func TestUnpackSliceOutOfRange(t *testing.T) {
+	for _, n := range []int{-1, 5} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("UnpackSlice(n=%d) did not panic", n)
+				}
+			}()
+			UnpackSlice([]uint16{0x3838, 0x3838}, n)
+		}()
+	}
+}
+
+func TestFloat8SliceWriteTo(t *testing.T) {
+	s := Float8Slice{One(), NegativeZero, NaN, MaxValue, NegativeInfinity}
+
+	var buf bytes.Buffer
+	n, err := s.WriteTo(&buf)
+	if err != nil {
+		t.Fatalf("WriteTo() error = %v", err)
+	}
+	if n != int64(buf.Len()) || n != int64(streamHeaderSize+len(s)) {
+		t.Errorf("WriteTo() = %d bytes, buffer has %d, want %d", n, buf.Len(), streamHeaderSize+len(s))
+	}
+	header := buf.Bytes()[:streamHeaderSize]
+	if string(header[:4]) != "FP8S" || header[4] != VersionMajor {
+		t.Errorf("WriteTo() header = %x, want magic FP8S and version %d", header, VersionMajor)
+	}
+
+	got, err := ReadSliceFrom(&buf)
+	if err != nil {
+		t.Fatalf("ReadSliceFrom() error = %v", err)
+	}
+	if len(got) != len(s) {
+		t.Fatalf("ReadSliceFrom() length = %d, want %d", len(got), len(s))
+	}
+	for i := range s {
+		if got[i] != s[i] {
+			t.Errorf("ReadSliceFrom()[%d] = 0x%02x, want 0x%02x", i, uint8(got[i]), uint8(s[i]))
+		}
+	}
+}
+
+func TestFloat8SliceReadFrom(t *testing.T) {
+	var buf bytes.Buffer
+	if _, err := (Float8Slice{One(), ToFloat8(2.0)}).WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo() error = %v", err)
+	}
+	encoded := buf.Bytes()
+
+	var s Float8Slice
+	n, err := s.ReadFrom(bytes.NewReader(encoded))
+	if err != nil || n != int64(len(encoded)) {
+		t.Fatalf("ReadFrom() = %d, %v, want %d, nil", n, err, len(encoded))
+	}
+	if len(s) != 2 || s[0] != One() || s[1] != ToFloat8(2.0) {
+		t.Errorf("ReadFrom() decoded %v, want [1 2]", s)
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		var buf bytes.Buffer
+		if _, err := (Float8Slice{}).WriteTo(&buf); err != nil {
+			t.Fatalf("WriteTo() error = %v", err)
+		}
+		got, err := ReadSliceFrom(&buf)
+		if err != nil || got == nil || len(got) != 0 {
+			t.Errorf("ReadSliceFrom() = %v, %v, want empty slice", got, err)
+		}
+	})
+}
+
+func TestReadSliceFromInvalid(t *testing.T) {
+	var buf bytes.Buffer
+	if _, err := (Float8Slice{One(), ToFloat8(2.0), ToFloat8(3.0)}).WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo() error = %v", err)
+	}
+	valid := buf.Bytes()
+
+	corrupt := func(i int, b byte) []byte {
+		data := bytes.Clone(valid)
+		data[i] = b
+		return data
+	}
+
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr error
+	}{
+		{"empty", nil, io.EOF},
+		{"short header", valid[:6], io.ErrUnexpectedEOF},
+		{"bad magic", corrupt(0, 'X'), nil},
+		{"bad version", corrupt(4, VersionMajor+1), nil},
+		{"truncated data", valid[:len(valid)-1], io.ErrUnexpectedEOF},
+		{"length too large", corrupt(5, 0xFF), io.ErrUnexpectedEOF},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadSliceFrom(bytes.NewReader(tt.data))
+			if err == nil {
+				t.Fatalf("ReadSliceFrom() = %v, want error", got)
+			}
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ReadSliceFrom() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			var ferr *Float8Error
+			if !errors.As(err, &ferr) || ferr.Op != "read" {
+				t.Errorf("ReadSliceFrom() error = %v, want *Float8Error with Op \"read\"", err)
+			}
+		})
+	}
+}
+
+func TestBitDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     Float8
+		expected int
+	}{
+		{"identical", One(), One(), 0},
+		{"NaN with itself", NaN, NaN, 0},
+		{"signed zeros", PositiveZero, NegativeZero, 1},
+		{"sign bit", One(), FromInt(-1), 1},
+		{"NaN encodings", NaN, NegativeNaN, 1},
+		{"complement", Float8(0x55), Float8(0xAA), 8},
+		{"adjacent", Float8(0x37), Float8(0x38), 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BitDiff(tt.a, tt.b); got != tt.expected {
+				t.Errorf("BitDiff(0x%02x, 0x%02x) = %d, want %d", uint8(tt.a), uint8(tt.b), got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestHammingDistance(t *testing.T) {
+	a := []Float8{One(), FromInt(2), NaN, PositiveZero}
+	if got := HammingDistance(a, a); got != 0 {
+		t.Errorf("HammingDistance(a, a) = %d, want 0", got)
+	}
+	if got := HammingDistance(nil, nil); got != 0 {
+		t.Errorf("HammingDistance(nil, nil) = %d, want 0", got)
+	}
+
+	b := slices.Clone(a)
+	b[1] = b[1].Neg()
+	if got := HammingDistance(a, b); got != 1 {
+		t.Errorf("HammingDistance after flipping one sign bit = %d, want 1", got)
+	}
+
+	b[3] = Float8(0x0F)
+	if got := HammingDistance(a, b); got != 5 {
+		t.Errorf("HammingDistance(%v, %v) = %d, want 5", a, b, got)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic but function completed successfully")
+		}
+	}()
+	HammingDistance(a, a[:2])
+}
@@ -1,6 +1,7 @@
 package float8
 
 import (
+	"math"
 	"sync"
 )
 
@@ -8,6 +9,10 @@ import (
 
 var (
 	initOnce sync.Once
+
+	// tablesMu serializes writers of the package-level lookup tables and
+	// configuration. Readers load the table pointers atomically instead.
+	tablesMu sync.Mutex
 )
 
 // Initialize performs one-time package initialization
@@ -24,6 +29,7 @@ type Config struct {
 	EnableFastConversion bool
 	DefaultMode          ConversionMode
 	ArithmeticMode       ArithmeticMode
+	FlushToZero          bool
 }
 
 // DefaultConfig returns the default package configuration
@@ -33,46 +39,125 @@ func DefaultConfig() *Config {
 		EnableFastConversion: false, // Disabled by default to save memory
 		DefaultMode:          ModeDefault,
 		ArithmeticMode:       ArithmeticAuto,
+		FlushToZero:          false, // Gradual underflow into subnormals
 	}
 }
 
-// Configure applies the given configuration to the package
+// Configure applies the given configuration to the package.
+//
+// Configure is not safe for concurrent use as a whole; only its lookup table
+// updates are. Enabling and disabling tables is safe while other goroutines
+// perform arithmetic and conversions, because the tables are published
+// atomically. DefaultConversionMode, DefaultArithmeticMode, and FlushToZero
+// are plain package variables read without synchronization by ToFloat8 and
+// the arithmetic functions, so changing any of them races with concurrent
+// operations and must only be done while none are in flight. Configure
+// leaves a mode untouched when its value is unchanged, so reapplying the
+// current modes is harmless. Use a Converter to run differently configured
+// operations side by side.
 func Configure(config *Config) {
+	tablesMu.Lock()
+	defer tablesMu.Unlock()
+	arithGeneration++
+
+	// Arithmetic tables bake in the flush-to-zero setting, so rebuild them if it changes
+	if config.FlushToZero != FlushToZero {
+		arithTables.Store(nil)
+		if recipTable.Load() != nil {
+			recipTable.Store(buildReciprocalTable(config.FlushToZero))
+		}
+		FlushToZero = config.FlushToZero
+	}
+	if config.DefaultMode != DefaultConversionMode {
+		DefaultConversionMode = config.DefaultMode
+	}
+	if config.ArithmeticMode != DefaultArithmeticMode {
+		DefaultArithmeticMode = config.ArithmeticMode
+	}
+
 	if config.EnableFastArithmetic {
-		EnableFastArithmetic()
+		initArithmeticTables()
 	} else {
-		DisableFastArithmetic()
+		arithTables.Store(nil)
 	}
 
 	if config.EnableFastConversion {
-		EnableFastConversion()
+		initConversionTable()
 	} else {
-		DisableFastConversion()
+		conversionTable.Store(nil)
+	}
+}
+
+// WithConfig applies config for the duration of fn and then restores the
+// previous configuration, even if fn panics. A nil config is equivalent to
+// DefaultConfig().
+//
+// The restored state is exactly the state before the call: the conversion,
+// arithmetic, and flush-to-zero modes, and each lookup table, including one
+// enabled by EnableReciprocalTable, are put back without being rebuilt.
+// Since the configuration is global, WithConfig is meant for tests and other
+// code that does not run operations on other goroutines at the same time; see
+// Configure.
+func WithConfig(config *Config, fn func()) {
+	if config == nil {
+		config = DefaultConfig()
 	}
+	defer snapshotConfig().restore()
+	Configure(config)
+	fn()
+}
 
-	DefaultConversionMode = config.DefaultMode
-	DefaultArithmeticMode = config.ArithmeticMode
+// configSnapshot is the package-level configuration saved by WithConfig
+type configSnapshot struct {
+	conversionMode  ConversionMode
+	arithmeticMode  ArithmeticMode
+	flushToZero     bool
+	conversionTable *[256]float32
+	arithTables     *arithmeticTables
+	recipTable      *[256]Float8
+}
+
+// snapshotConfig captures the current package-level configuration
+func snapshotConfig() configSnapshot {
+	tablesMu.Lock()
+	defer tablesMu.Unlock()
+	return configSnapshot{
+		conversionMode:  DefaultConversionMode,
+		arithmeticMode:  DefaultArithmeticMode,
+		flushToZero:     FlushToZero,
+		conversionTable: conversionTable.Load(),
+		arithTables:     arithTables.Load(),
+		recipTable:      recipTable.Load(),
+	}
+}
+
+// restore reinstates the configuration captured by snapshotConfig
+func (s configSnapshot) restore() {
+	tablesMu.Lock()
+	defer tablesMu.Unlock()
+	arithGeneration++
+	DefaultConversionMode = s.conversionMode
+	DefaultArithmeticMode = s.arithmeticMode
+	FlushToZero = s.flushToZero
+	conversionTable.Store(s.conversionTable)
+	arithTables.Store(s.arithTables)
+	recipTable.Store(s.recipTable)
 }
 
 // GetMemoryUsage returns the current memory usage of lookup tables in bytes
 func GetMemoryUsage() int {
 	var usage int
 
-	if conversionTable != nil {
+	if conversionTable.Load() != nil {
 		usage += 256 * 4 // 256 float32 values
 	}
 
-	if addTable != nil {
-		usage += 65536 // 65536 uint8 values
-	}
-	if subTable != nil {
-		usage += 65536
+	if arithTables.Load() != nil {
+		usage += 4 * 65536 // add, sub, mul, div tables of 65536 uint8 values
 	}
-	if mulTable != nil {
-		usage += 65536
-	}
-	if divTable != nil {
-		usage += 65536
+
+	if recipTable.Load() != nil {
+		usage += 256 // reciprocal table of 256 uint8 values
 	}
 
 	return usage
@@ -123,6 +208,37 @@ func (f Float8) ToInt() int {
 	return int(f.ToFloat32())
 }
 
+// RoundToInt converts a Float8 to int, rounding to the nearest integer with
+// ties to even. The result is unspecified if f is NaN or infinite; use
+// ToIntChecked to detect those values.
+func (f Float8) RoundToInt() int {
+	return int(math.RoundToEven(float64(f.ToFloat32())))
+}
+
+// CeilToInt converts a Float8 to the least int greater than or equal to f.
+// The result is unspecified if f is NaN or infinite.
+func (f Float8) CeilToInt() int {
+	return int(math.Ceil(float64(f.ToFloat32())))
+}
+
+// FloorToInt converts a Float8 to the greatest int less than or equal to f.
+// The result is unspecified if f is NaN or infinite.
+func (f Float8) FloorToInt() int {
+	return int(math.Floor(float64(f.ToFloat32())))
+}
+
+// ToIntChecked converts a Float8 to int, truncating toward zero like ToInt.
+// It returns an error if f is NaN or infinite. Every finite Float8 fits in an int.
+func (f Float8) ToIntChecked() (int, error) {
+	switch {
+	case f.IsNaN():
+		return 0, &Float8Error{Op: "toint", Msg: "NaN has no integer value"}
+	case f.IsInf():
+		return 0, &Float8Error{Op: "toint", Value: f.ToFloat32(), Msg: "infinity has no integer value"}
+	}
+	return f.ToInt(), nil
+}
+
 // Validation functions
 
 // IsValid returns true if the Float8 represents a valid number
@@ -131,9 +247,9 @@ func (f Float8) IsValid() bool {
 	return true
 }
 
-// IsNormal returns true if the Float8 is a normal (non-zero, non-infinite) number
+// IsNormal returns true if the Float8 is a normal (non-zero, non-subnormal, finite) number
 func (f Float8) IsNormal() bool {
-	return !f.IsZero() && f.IsFinite()
+	return f.IsFinite() && f&ExponentMask != 0
 }
 
 // Package information for debugging
@@ -143,9 +259,10 @@ func DebugInfo() map[string]interface{} {
 	return map[string]interface{}{
 		"version":            Version,
 		"memory_usage_bytes": GetMemoryUsage(),
-		"fast_arithmetic":    addTable != nil,
-		"fast_conversion":    conversionTable != nil,
+		"fast_arithmetic":    arithTables.Load() != nil,
+		"fast_conversion":    conversionTable.Load() != nil,
 		"default_conv_mode":  DefaultConversionMode,
 		"default_arith_mode": DefaultArithmeticMode,
+		"flush_to_zero":      FlushToZero,
 	}
 }
@@ -2,6 +2,7 @@ package float8
 
 import (
 	"math"
+	"sync"
 	"testing"
 )
 
@@ -37,6 +38,9 @@ func TestDefaultConfig(t *testing.T) {
 		if config.ArithmeticMode != ArithmeticAuto {
 			t.Errorf("Expected ArithmeticMode to be ArithmeticAuto, got %v", config.ArithmeticMode)
 		}
+		if config.FlushToZero {
+			t.Error("Expected FlushToZero to be false by default")
+		}
 	} else {
 		t.Error("DefaultConfig() returned nil")
 	}
@@ -167,6 +171,76 @@ func TestToInt(t *testing.T) {
 	}
 }
 
+func TestRoundingToInt(t *testing.T) {
+	tests := []struct {
+		name               string
+		input              Float8
+		round, ceil, floor int
+	}{
+		{"Zero", PositiveZero, 0, 0, 0},
+		{"NegativeZero", NegativeZero, 0, 0, 0},
+		{"Two", FromInt(2), 2, 2, 2},
+		{"1.5 ties to even", ToFloat8(1.5), 2, 2, 1},
+		{"2.5 ties to even", ToFloat8(2.5), 2, 3, 2},
+		{"3.5 ties to even", ToFloat8(3.5), 4, 4, 3},
+		{"2.75", ToFloat8(2.75), 3, 3, 2},
+		{"-1.5 ties to even", ToFloat8(-1.5), -2, -1, -2},
+		{"-2.5 ties to even", ToFloat8(-2.5), -2, -2, -3},
+		{"-0.25", ToFloat8(-0.25), 0, 0, -1},
+		{"MaxValue", MaxValue, 448, 448, 448},
+		{"MinValue", MinValue, -448, -448, -448},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.RoundToInt(); got != tt.round {
+				t.Errorf("%v.RoundToInt() = %d, want %d", tt.input, got, tt.round)
+			}
+			if got := tt.input.CeilToInt(); got != tt.ceil {
+				t.Errorf("%v.CeilToInt() = %d, want %d", tt.input, got, tt.ceil)
+			}
+			if got := tt.input.FloorToInt(); got != tt.floor {
+				t.Errorf("%v.FloorToInt() = %d, want %d", tt.input, got, tt.floor)
+			}
+		})
+	}
+}
+
+func TestToIntChecked(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Float8
+		expected int
+		wantErr  bool
+	}{
+		{"One", One(), 1, false},
+		{"-2.75 truncates", ToFloat8(-2.75), -2, false},
+		{"MaxValue", MaxValue, 448, false},
+		{"PositiveInfinity", PositiveInfinity, 0, true},
+		{"NegativeInfinity", NegativeInfinity, 0, true},
+		{"NaN", NaN, 0, true},
+		{"NegativeNaN", 0xFF, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.input.ToIntChecked()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("%v.ToIntChecked() error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if err != nil {
+				if _, ok := err.(*Float8Error); !ok {
+					t.Errorf("%v.ToIntChecked() error type = %T, want *Float8Error", tt.input, err)
+				}
+				return
+			}
+			if result != tt.expected {
+				t.Errorf("%v.ToIntChecked() = %d, want %d", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
 func TestFloat64Conversions(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -229,6 +303,10 @@ func TestIsNormal(t *testing.T) {
 	}{
 		{"Zero", 0x00, false},
 		{"One", 0x38, true},
+		{"Subnormal", 0x01, false},
+		{"MaxValue", 0x7E, true},
+		{"Infinity", 0x78, false},
+		{"NaN", 0x7F, false},
 	}
 
 	for _, tt := range tests {
@@ -253,3 +331,121 @@ func TestDebugInfo(t *testing.T) {
 		t.Error("DebugInfo() missing version key")
 	}
 }
+
+// TestConfigureConcurrent toggles the lookup tables while other goroutines
+// perform arithmetic and conversions. Run with -race to detect data races.
+func TestConfigureConcurrent(t *testing.T) {
+	origConfig := DefaultConfig()
+	Configure(origConfig)
+	defer Configure(origConfig)
+
+	a, b := ToFloat8(1.5), ToFloat8(2.0)
+	wantSum := addAlgorithmic(a, b, false)
+	wantProd := mulAlgorithmic(a, b, false)
+
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				if got := Add(a, b); got != wantSum {
+					t.Errorf("Add(%v, %v) = %v, want %v", a, b, got, wantSum)
+					return
+				}
+				if got := Mul(a, b); got != wantProd {
+					t.Errorf("Mul(%v, %v) = %v, want %v", a, b, got, wantProd)
+					return
+				}
+				if got := a.ToFloat32(); got != 1.5 {
+					t.Errorf("ToFloat32() = %v, want 1.5", got)
+					return
+				}
+				_ = GetMemoryUsage()
+			}
+		}()
+	}
+
+	for i := 0; i < 20; i++ {
+		config := DefaultConfig()
+		config.EnableFastArithmetic = i%2 == 0
+		config.EnableFastConversion = i%3 == 0
+		Configure(config)
+		EnableFastConversion()
+		DisableFastArithmetic()
+	}
+
+	close(done)
+	wg.Wait()
+}
+
+func TestWithConfig(t *testing.T) {
+	Configure(DefaultConfig())
+	defer Configure(DefaultConfig())
+	EnableReciprocalTable()
+	defer DisableReciprocalTable()
+	recip := recipTable.Load()
+
+	config := &Config{
+		EnableFastArithmetic: true,
+		EnableFastConversion: true,
+		DefaultMode:          ModeStrict,
+		ArithmeticMode:       ArithmeticAlgorithmic,
+		FlushToZero:          true,
+	}
+	WithConfig(config, func() {
+		if DefaultConversionMode != ModeStrict || DefaultArithmeticMode != ArithmeticAlgorithmic || !FlushToZero {
+			t.Errorf("modes inside WithConfig = (%v, %v, %v), want (strict, algorithmic, true)",
+				DefaultConversionMode, DefaultArithmeticMode, FlushToZero)
+		}
+		if arithTables.Load() == nil || conversionTable.Load() == nil {
+			t.Error("Expected tables to be enabled inside WithConfig")
+		}
+		DisableReciprocalTable()
+	})
+
+	if DefaultConversionMode != ModeDefault || DefaultArithmeticMode != ArithmeticAuto || FlushToZero {
+		t.Errorf("modes after WithConfig = (%v, %v, %v), want the defaults",
+			DefaultConversionMode, DefaultArithmeticMode, FlushToZero)
+	}
+	if arithTables.Load() != nil || conversionTable.Load() != nil {
+		t.Error("Expected tables to be disabled after WithConfig")
+	}
+	if recipTable.Load() != recip {
+		t.Error("WithConfig did not restore the reciprocal table")
+	}
+
+	t.Run("restores after panic", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected panic but function completed successfully")
+			}
+			if DefaultConversionMode != ModeDefault {
+				t.Errorf("DefaultConversionMode after panic = %v, want ModeDefault", DefaultConversionMode)
+			}
+		}()
+		WithConfig(&Config{DefaultMode: ModeStrict}, func() {
+			panic("boom")
+		})
+	})
+
+	t.Run("nil config applies defaults", func(t *testing.T) {
+		DefaultArithmeticMode = ArithmeticAlgorithmic
+		defer func() { DefaultArithmeticMode = ArithmeticAuto }()
+		WithConfig(nil, func() {
+			if DefaultArithmeticMode != ArithmeticAuto {
+				t.Errorf("DefaultArithmeticMode inside WithConfig(nil) = %v, want ArithmeticAuto", DefaultArithmeticMode)
+			}
+		})
+		if DefaultArithmeticMode != ArithmeticAlgorithmic {
+			t.Errorf("DefaultArithmeticMode after WithConfig(nil) = %v, want ArithmeticAlgorithmic", DefaultArithmeticMode)
+		}
+	})
+}
@@ -98,9 +98,9 @@ func TestToFloat32(t *testing.T) {
 	// Test with lookup table disabled to ensure algorithmic path is tested
 	t.Run("algorithmic path", func(t *testing.T) {
 		// Save current state
-		table := conversionTable
-		conversionTable = nil
-		defer func() { conversionTable = table }() // Restore after test
+		table := conversionTable.Load()
+		conversionTable.Store(nil)
+		defer func() { conversionTable.Store(table) }() // Restore after test
 
 		for _, test := range tests {
 			t.Run(test.name, func(t *testing.T) {
@@ -134,7 +134,7 @@ func TestToFloat32(t *testing.T) {
 	// Test with lookup table enabled (if available)
 	t.Run("lookup table path", func(t *testing.T) {
 		// Ensure lookup table is enabled
-		if conversionTable == nil {
+		if conversionTable.Load() == nil {
 			initConversionTable()
 		}
 
@@ -237,37 +237,11 @@ func TestAddBasic(t *testing.T) {
 					// Test AddWithMode with the current mode
 					result := AddWithMode(test.a, test.b, mode.name)
 
-					// For imprecise operations, allow a small tolerance
+					// For imprecise operations, allow the result to be one ULP away
 					if test.imprecise {
-						tolerance := ToFloat8(0.01)
-						diff := result - test.expected
-						absDiff := diff
-						if absDiff < 0 {
-							t.Logf("Negative diff, taking absolute value")
-							t.Logf("diff type: %T, tolerance type: %T", diff, tolerance)
-							t.Logf("diff value: %v, tolerance value: %v", diff, tolerance)
-							t.Logf("result: %v, expected: %v", result, test.expected)
-							t.Logf("result bits: %08b, expected bits: %08b", result, test.expected)
-							t.Logf("result float: %v, expected float: %v", result.ToFloat32(), test.expected.ToFloat32())
-							t.Logf("result == expected: %v", result == test.expected)
-							t.Logf("diff < -tolerance: %v, diff > tolerance: %v", diff < -tolerance, diff > tolerance)
-							t.Logf("diff < -tolerance: %v < -%v: %v, diff > tolerance: %v > %v: %v",
-								diff, tolerance, diff < -tolerance,
-								diff, tolerance, diff > tolerance)
-							absDiff = -absDiff
-						}
-
-						if absDiff > tolerance {
-							t.Errorf("AddWithMode(%s, %s, %s) = %s (0x%02x, %v), expected close to %s (0x%02x, %v), diff=%v, tolerance=%v, absDiff=%v",
-								test.a, test.b, mode.desc,
-								result, result, result.ToFloat32(),
-								test.expected, test.expected, test.expected.ToFloat32(),
-								diff, tolerance, absDiff)
-						} else {
-							// If we get here, the result is within tolerance, so the test passes
-							t.Logf("Test passed: result %v is within tolerance %v of expected %v (diff=%v, absDiff=%v)",
-								result, tolerance, test.expected, diff, absDiff)
-							return
+						if !AlmostEqualULP(result, test.expected, 1) {
+							t.Errorf("AddWithMode(%s, %s, %s) = %s (0x%02x), expected within 1 ULP of %s (0x%02x)",
+								test.a, test.b, mode.desc, result, uint8(result), test.expected, uint8(test.expected))
 						}
 					} else if result != test.expected {
 						t.Errorf("AddWithMode(0x%02x, 0%02x, %s) = 0x%02x, expected 0x%02x",
@@ -344,37 +318,11 @@ func TestSubBasic(t *testing.T) {
 					// Test SubWithMode with the current mode
 					result := SubWithMode(test.a, test.b, mode.name)
 
-					// For imprecise operations, allow a small tolerance
+					// For imprecise operations, allow the result to be one ULP away
 					if test.imprecise {
-						tolerance := ToFloat8(0.01)
-						diff := result - test.expected
-						absDiff := diff
-						if absDiff < 0 {
-							t.Logf("Negative diff, taking absolute value")
-							t.Logf("diff type: %T, tolerance type: %T", diff, tolerance)
-							t.Logf("diff value: %v, tolerance value: %v", diff, tolerance)
-							t.Logf("result: %v, expected: %v", result, test.expected)
-							t.Logf("result bits: %08b, expected bits: %08b", result, test.expected)
-							t.Logf("result float: %v, expected float: %v", result.ToFloat32(), test.expected.ToFloat32())
-							t.Logf("result == expected: %v", result == test.expected)
-							t.Logf("diff < -tolerance: %v, diff > tolerance: %v", diff < -tolerance, diff > tolerance)
-							t.Logf("diff < -tolerance: %v < -%v: %v, diff > tolerance: %v > %v: %v",
-								diff, tolerance, diff < -tolerance,
-								diff, tolerance, diff > tolerance)
-							absDiff = -absDiff
-						}
-
-						if absDiff > tolerance {
-							t.Errorf("SubWithMode(%s, %s, %s) = %s (0x%02x, %v), expected close to %s (0x%02x, %v), diff=%v, tolerance=%v, absDiff=%v",
-								test.a, test.b, mode.desc,
-								result, result, result.ToFloat32(),
-								test.expected, test.expected, test.expected.ToFloat32(),
-								diff, tolerance, absDiff)
-						} else {
-							// If we get here, the result is within tolerance, so the test passes
-							t.Logf("Test passed: result %v is within tolerance %v of expected %v (diff=%v, absDiff=%v)",
-								result, tolerance, test.expected, diff, absDiff)
-							return
+						if !AlmostEqualULP(result, test.expected, 1) {
+							t.Errorf("SubWithMode(%s, %s, %s) = %s (0x%02x), expected within 1 ULP of %s (0x%02x)",
+								test.a, test.b, mode.desc, result, uint8(result), test.expected, uint8(test.expected))
 						}
 					} else if result != test.expected {
 						t.Errorf("SubWithMode(0x%02x, 0%02x, %s) = 0x%02x, expected 0x%02x",
@@ -483,37 +431,11 @@ func TestMulBasic(t *testing.T) {
 					// Test MulWithMode with the current mode
 					result := MulWithMode(test.a, test.b, mode.name)
 
-					// For imprecise operations, allow a small tolerance
+					// For imprecise operations, allow the result to be one ULP away
 					if test.imprecise {
-						tolerance := ToFloat8(0.01)
-						diff := result - test.expected
-						absDiff := diff
-						if absDiff < 0 {
-							t.Logf("Negative diff, taking absolute value")
-							t.Logf("diff type: %T, tolerance type: %T", diff, tolerance)
-							t.Logf("diff value: %v, tolerance value: %v", diff, tolerance)
-							t.Logf("result: %v, expected: %v", result, test.expected)
-							t.Logf("result bits: %08b, expected bits: %08b", result, test.expected)
-							t.Logf("result float: %v, expected float: %v", result.ToFloat32(), test.expected.ToFloat32())
-							t.Logf("result == expected: %v", result == test.expected)
-							t.Logf("diff < -tolerance: %v, diff > tolerance: %v", diff < -tolerance, diff > tolerance)
-							t.Logf("diff < -tolerance: %v < -%v: %v, diff > tolerance: %v > %v: %v",
-								diff, tolerance, diff < -tolerance,
-								diff, tolerance, diff > tolerance)
-							absDiff = -absDiff
-						}
-
-						if absDiff > tolerance {
-							t.Errorf("MulWithMode(%s, %s, %s) = %s (0x%02x, %v), expected close to %s (0x%02x, %v), diff=%v, tolerance=%v, absDiff=%v",
-								test.a, test.b, mode.desc,
-								result, result, result.ToFloat32(),
-								test.expected, test.expected, test.expected.ToFloat32(),
-								diff, tolerance, absDiff)
-						} else {
-							// If we get here, the result is within tolerance, so the test passes
-							t.Logf("Test passed: result %v is within tolerance %v of expected %v (diff=%v, absDiff=%v)",
-								result, tolerance, test.expected, diff, absDiff)
-							return
+						if !AlmostEqualULP(result, test.expected, 1) {
+							t.Errorf("MulWithMode(%s, %s, %s) = %s (0x%02x), expected within 1 ULP of %s (0x%02x)",
+								test.a, test.b, mode.desc, result, uint8(result), test.expected, uint8(test.expected))
 						}
 					} else if result != test.expected {
 						t.Errorf("MulWithMode(0x%02x, 0%02x, %s) = 0x%02x, expected 0x%02x",
@@ -634,63 +556,11 @@ func TestDivBasic(t *testing.T) {
 					// Test DivWithMode with the current mode
 					result := DivWithMode(test.a, test.b, mode.name)
 
-					// For imprecise operations, allow a small tolerance
+					// For imprecise operations, allow the result to be one ULP away
 					if test.imprecise {
-						tolerance := ToFloat8(0.01)
-						diff := result - test.expected
-						absDiff := diff
-						if absDiff < 0 {
-							t.Logf("Negative diff, taking absolute value")
-							t.Logf("diff type: %T, tolerance type: %T", diff, tolerance)
-							t.Logf("diff value: %v, tolerance value: %v", diff, tolerance)
-							t.Logf("result: %v, expected: %v", result, test.expected)
-							t.Logf("result bits: %08b, expected bits: %08b", result, test.expected)
-							t.Logf("result float: %v, expected float: %v", result.ToFloat32(), test.expected.ToFloat32())
-							t.Logf("result == expected: %v", result == test.expected)
-							t.Logf("diff < -tolerance: %v, diff > tolerance: %v", diff < -tolerance, diff > tolerance)
-							t.Logf("diff < -tolerance: %v < -%v: %v, diff > tolerance: %v > %v: %v",
-								diff, tolerance, diff < -tolerance,
-								diff, tolerance, diff > tolerance)
-							t.Logf("result / expected: %v, expected / result: %v",
-								result.ToFloat32()/test.expected.ToFloat32(),
-								test.expected.ToFloat32()/result.ToFloat32())
-							t.Logf("1 - (result / expected): %v, 1 - (expected / result): %v",
-								1-result.ToFloat32()/test.expected.ToFloat32(),
-								1-test.expected.ToFloat32()/result.ToFloat32())
-							absDiff = -absDiff
-						}
-
-						// For division, we should also check the relative error
-						// since small absolute differences can be significant for small numbers
-						relTolerance := float32(0.05) // 5% relative tolerance
-						resultF := result.ToFloat32()
-						expectedF := test.expected.ToFloat32()
-
-						// Avoid division by zero or very small numbers
-						if expectedF != 0 && abs(float32(absDiff)) > tolerance.ToFloat32() {
-							relDiff := abs(1 - (resultF / expectedF))
-							if relDiff > relTolerance {
-								t.Errorf("DivWithMode(%s, %s, %s) = %s (0x%02x, %v), expected close to %s (0x%02x, %v), diff=%v, absDiff=%v, relDiff=%.2f%%",
-									test.a, test.b, mode.desc,
-									result, result, resultF,
-									test.expected, test.expected, expectedF,
-									diff, absDiff, relDiff*100)
-							} else {
-								t.Logf("Test passed within relative tolerance: result %v is within %.2f%% of expected %v (diff=%v, absDiff=%v, relDiff=%.2f%%)",
-									result, relTolerance*100, test.expected, diff, absDiff, relDiff*100)
-								return
-							}
-						} else if absDiff > tolerance {
-							t.Errorf("DivWithMode(%s, %s, %s) = %s (0x%02x, %v), expected close to %s (0x%02x, %v), diff=%v, tolerance=%v, absDiff=%v",
-								test.a, test.b, mode.desc,
-								result, result, resultF,
-								test.expected, test.expected, expectedF,
-								diff, tolerance, absDiff)
-						} else {
-							// If we get here, the result is within tolerance, so the test passes
-							t.Logf("Test passed: result %v is within tolerance %v of expected %v (diff=%v, absDiff=%v)",
-								result, tolerance, test.expected, diff, absDiff)
-							return
+						if !AlmostEqualULP(result, test.expected, 1) {
+							t.Errorf("DivWithMode(%s, %s, %s) = %s (0x%02x), expected within 1 ULP of %s (0x%02x)",
+								test.a, test.b, mode.desc, result, uint8(result), test.expected, uint8(test.expected))
 						}
 					} else if result != test.expected {
 						t.Errorf("DivWithMode(0x%02x, 0%02x, %s) = 0x%02x, expected 0x%02x",
@@ -1415,13 +1285,13 @@ func TestConfigure(t *testing.T) {
 			Configure(config)
 
 			// Verify the configuration was applied correctly
-			if addTable != nil != tt.expectedArithTables {
+			if arithTables.Load() != nil != tt.expectedArithTables {
 				t.Errorf("Unexpected arithmetic tables state: got %v, want %v",
-					addTable != nil, tt.expectedArithTables)
+					arithTables.Load() != nil, tt.expectedArithTables)
 			}
-			if conversionTable != nil != tt.expectedConvTable {
+			if conversionTable.Load() != nil != tt.expectedConvTable {
 				t.Errorf("Unexpected conversion table state: got %v, want %v",
-					conversionTable != nil, tt.expectedConvTable)
+					conversionTable.Load() != nil, tt.expectedConvTable)
 			}
 			if DefaultConversionMode != tt.defaultMode {
 				t.Errorf("DefaultConversionMode = %v, want %v",
@@ -0,0 +1,81 @@
+package float8
+
+import (
+	"math"
+)
+
+// Slice generators
+//
+// Generated values are computed in float32 from the endpoints and rounded to
+// Float8 individually, so rounding errors do not accumulate along the slice.
+// Because Float8 is coarse, neighboring points often round to the same value,
+// and adjacent outputs may be equal.
+
+// Zeros returns a slice of n PositiveZero values.
+func Zeros(n int) []Float8 {
+	return make([]Float8, n)
+}
+
+// Ones returns a slice of n values equal to One().
+func Ones(n int) []Float8 {
+	return Full(n, One())
+}
+
+// Full returns a slice of n values equal to v.
+func Full(n int, v Float8) []Float8 {
+	result := make([]Float8, n)
+	Fill(result, v)
+	return result
+}
+
+// Fill sets every element of s to v.
+func Fill(s []Float8, v Float8) {
+	for i := range s {
+		s[i] = v
+	}
+}
+
+// Linspace returns n evenly spaced values from start to stop inclusive.
+//
+// The first and last values are exactly start and stop. Linspace returns
+// [start] if n is 1 and nil if n is less than 1.
+func Linspace(start, stop Float8, n int) []Float8 {
+	if n < 1 {
+		return nil
+	}
+	result := make([]Float8, n)
+	result[0] = start
+	if n == 1 {
+		return result
+	}
+
+	a, b := start.ToFloat32(), stop.ToFloat32()
+	step := (b - a) / float32(n-1)
+	for i := 1; i < n-1; i++ {
+		result[i] = ToFloat8(a + float32(i)*step)
+	}
+	result[n-1] = stop
+	return result
+}
+
+// Arange returns the values start, start+step, start+2*step, ... up to but
+// not including stop. A negative step counts down towards stop.
+//
+// Arange returns nil if no values lie in the interval, or if step is zero or
+// any argument is NaN or infinite.
+func Arange(start, stop, step Float8) []Float8 {
+	if !start.IsFinite() || !stop.IsFinite() || !step.IsFinite() || step.IsZero() {
+		return nil
+	}
+
+	a, b, d := start.ToFloat32(), stop.ToFloat32(), step.ToFloat32()
+	n := int(math.Ceil(float64((b - a) / d)))
+	if n <= 0 {
+		return nil
+	}
+	result := make([]Float8, n)
+	for i := range result {
+		result[i] = ToFloat8(a + float32(i)*d)
+	}
+	return result
+}
@@ -0,0 +1,119 @@
+package float8
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestConstructors(t *testing.T) {
+	tests := []struct {
+		name  string
+		got   []Float8
+		value Float8
+		n     int
+	}{
+		{"Zeros", Zeros(3), PositiveZero, 3},
+		{"Ones", Ones(4), One(), 4},
+		{"Full", Full(5, FromInt(-2)), FromInt(-2), 5},
+		{"FullNaN", Full(2, NaN), NaN, 2},
+		{"FullNegativeInfinity", Full(3, NegativeInfinity), NegativeInfinity, 3},
+		{"Empty", Ones(0), One(), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.got) != tt.n {
+				t.Fatalf("length = %d, want %d", len(tt.got), tt.n)
+			}
+			for i, v := range tt.got {
+				// Compare bit patterns so NaN is checked too
+				if v.Bits() != tt.value.Bits() {
+					t.Errorf("At index %d: got 0x%02x, want 0x%02x", i, v.Bits(), tt.value.Bits())
+				}
+			}
+		})
+	}
+
+	t.Run("Fill", func(t *testing.T) {
+		s := []Float8{One(), FromInt(2), FromInt(3)}
+		Fill(s[1:], MaxValue)
+		if !slices.Equal(s, []Float8{One(), MaxValue, MaxValue}) {
+			t.Errorf("Fill(s[1:], MaxValue) left s = %v", s)
+		}
+	})
+}
+
+func TestLinspace(t *testing.T) {
+	tests := []struct {
+		name        string
+		start, stop Float8
+		n           int
+		expected    []Float8
+	}{
+		{"integers", PositiveZero, FromInt(4), 5, []Float8{PositiveZero, One(), FromInt(2), FromInt(3), FromInt(4)}},
+		{"descending", FromInt(2), FromInt(-2), 3, []Float8{FromInt(2), PositiveZero, FromInt(-2)}},
+		{"fractions", PositiveZero, One(), 5, []Float8{PositiveZero, ToFloat8(0.25), ToFloat8(0.5), ToFloat8(0.75), One()}},
+		{"single", FromInt(3), FromInt(7), 1, []Float8{FromInt(3)}},
+		{"endpoints only", FromInt(3), FromInt(7), 2, []Float8{FromInt(3), FromInt(7)}},
+		{"zero count", FromInt(3), FromInt(7), 0, nil},
+		{"negative count", FromInt(3), FromInt(7), -1, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Linspace(tt.start, tt.stop, tt.n); !slices.Equal(got, tt.expected) {
+				t.Errorf("Linspace(%v, %v, %d) = %v, want %v", tt.start, tt.stop, tt.n, got, tt.expected)
+			}
+		})
+	}
+
+	t.Run("exact endpoints with many points", func(t *testing.T) {
+		start, stop := ToFloat8(-3.25), ToFloat8(416)
+		got := Linspace(start, stop, 100)
+		if len(got) != 100 {
+			t.Fatalf("len(Linspace(..., 100)) = %d, want 100", len(got))
+		}
+		if got[0] != start || got[99] != stop {
+			t.Errorf("Linspace endpoints = %v, %v, want %v, %v", got[0], got[99], start, stop)
+		}
+		for i := 1; i < len(got); i++ {
+			if Less(got[i], got[i-1]) {
+				t.Errorf("Linspace not monotonic at %d: %v after %v", i, got[i], got[i-1])
+			}
+		}
+	})
+}
+
+func TestArange(t *testing.T) {
+	tests := []struct {
+		name              string
+		start, stop, step Float8
+		expected          []Float8
+	}{
+		{"integers", PositiveZero, FromInt(4), One(), []Float8{PositiveZero, One(), FromInt(2), FromInt(3)}},
+		{"fractional step", PositiveZero, One(), ToFloat8(0.25), []Float8{PositiveZero, ToFloat8(0.25), ToFloat8(0.5), ToFloat8(0.75)}},
+		{"partial last step", PositiveZero, FromInt(5), FromInt(2), []Float8{PositiveZero, FromInt(2), FromInt(4)}},
+		{"negative step", FromInt(3), PositiveZero, FromInt(-1), []Float8{FromInt(3), FromInt(2), One()}},
+		{"empty interval", FromInt(3), FromInt(3), One(), nil},
+		{"wrong direction", PositiveZero, FromInt(4), FromInt(-1), nil},
+		{"zero step", PositiveZero, FromInt(4), PositiveZero, nil},
+		{"NaN step", PositiveZero, FromInt(4), NaN, nil},
+		{"infinite stop", PositiveZero, PositiveInfinity, One(), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Arange(tt.start, tt.stop, tt.step); !slices.Equal(got, tt.expected) {
+				t.Errorf("Arange(%v, %v, %v) = %v, want %v", tt.start, tt.stop, tt.step, got, tt.expected)
+			}
+		})
+	}
+
+	t.Run("count", func(t *testing.T) {
+		// 0, 0.125, ..., 9.875: 80 points, many of which round to the same Float8
+		got := Arange(PositiveZero, FromInt(10), ToFloat8(0.125))
+		if len(got) != 80 {
+			t.Errorf("len(Arange(0, 10, 0.125)) = %d, want 80", len(got))
+		}
+	})
+}
@@ -2,6 +2,7 @@ package float8
 
 import (
 	"math"
+	"math/bits"
 )
 
 // Mathematical functions for Float8
@@ -89,6 +90,48 @@ func Pow(f, exp Float8) Float8 {
 	return ToFloat8(result)
 }
 
+// PowInt returns f raised to the integer power n.
+//
+// It uses exponentiation by squaring in float32 and rounds once at the end,
+// so integer powers avoid the logarithm-based path of Pow.
+//
+// Special cases are:
+//
+//	PowInt(f, 0) = 1 for any f (including NaN)
+//	PowInt(NaN, n) = NaN
+//	PowInt(±0, n) = ±0 for n > 0 odd, +0 for n > 0 even
+//	PowInt(±0, n) = ±Inf for n < 0 odd, +Inf for n < 0 even
+//	PowInt(f, n) for f < 0 is negative for odd n and positive for even n
+//
+// The result is rounded to the nearest representable Float8 value.
+func PowInt(f Float8, n int) Float8 {
+	if n == 0 {
+		return One()
+	}
+	if f.IsNaN() {
+		return f
+	}
+
+	// Use an unsigned magnitude so that n = math.MinInt does not overflow
+	m := uint(n)
+	if n < 0 {
+		m = -m
+	}
+	base := f.ToFloat32()
+	result := float32(1)
+	for m > 0 {
+		if m&1 != 0 {
+			result *= base
+		}
+		base *= base
+		m >>= 1
+	}
+	if n < 0 {
+		result = 1 / result
+	}
+	return ToFloat8(result)
+}
+
 // Exp returns e^f
 func Exp(f Float8) Float8 {
 	if f == PositiveZero || f == NegativeZero {
@@ -106,6 +149,61 @@ func Exp(f Float8) Float8 {
 	return ToFloat8(result)
 }
 
+// Exp2 returns 2^f, the base-2 exponential of f.
+//
+// Special cases are:
+//
+//	Exp2(±0) = 1
+//	Exp2(+Inf) = +Inf
+//	Exp2(-Inf) = +0
+//	Exp2(NaN) = NaN
+//
+// Results beyond the finite range round to +Inf, which happens for f above
+// about 8.86 (log2 of 464, halfway between MaxValue and the next power).
+func Exp2(f Float8) Float8 {
+	if f == PositiveZero || f == NegativeZero {
+		return One()
+	}
+	if f == PositiveInfinity {
+		return PositiveInfinity
+	}
+	if f == NegativeInfinity {
+		return PositiveZero
+	}
+
+	f32 := f.ToFloat32()
+	result := float32(math.Exp2(float64(f32)))
+	return ToFloat8(result)
+}
+
+// Pow10 returns 10^f, the base-10 exponential of f.
+//
+// Special cases are:
+//
+//	Pow10(±0) = 1
+//	Pow10(+Inf) = +Inf
+//	Pow10(-Inf) = +0
+//	Pow10(NaN) = NaN
+//
+// Results beyond the finite range round to +Inf, which happens for f above
+// about 2.67.
+func Pow10(f Float8) Float8 {
+	if f == PositiveZero || f == NegativeZero {
+		return One()
+	}
+	if f == PositiveInfinity {
+		return PositiveInfinity
+	}
+	if f == NegativeInfinity {
+		return PositiveZero
+	}
+
+	// math.Pow10 only accepts integer exponents
+	f32 := f.ToFloat32()
+	result := float32(math.Pow(10, float64(f32)))
+	return ToFloat8(result)
+}
+
 // Log returns the natural logarithm of f.
 //
 // Special cases are:
@@ -134,6 +232,72 @@ func Log(f Float8) Float8 {
 	return ToFloat8(result)
 }
 
+// Gamma returns the Gamma function of f.
+//
+// Special cases are:
+//
+//	Gamma(+Inf) = +Inf
+//	Gamma(±0) = ±Inf
+//	Gamma(x) = NaN for integer x < 0
+//	Gamma(-Inf) = NaN
+//	Gamma(NaN) = NaN
+//
+// Gamma(n) = (n-1)! for positive integers n, so Gamma(6) = 120 is the largest
+// factorial in range; results above MaxValue round to +Inf.
+func Gamma(f Float8) Float8 {
+	f32 := f.ToFloat32()
+	result := float32(math.Gamma(float64(f32)))
+	return ToFloat8(result)
+}
+
+// Lgamma returns the natural logarithm and sign (-1 or +1) of Gamma(f).
+//
+// Special cases are:
+//
+//	Lgamma(+Inf) = +Inf
+//	Lgamma(0) = +Inf
+//	Lgamma(-integer) = +Inf
+//	Lgamma(-Inf) = -Inf
+//	Lgamma(NaN) = NaN
+func Lgamma(f Float8) (lgamma Float8, sign int) {
+	f32 := f.ToFloat32()
+	result, sign := math.Lgamma(float64(f32))
+	return ToFloat8(float32(result)), sign
+}
+
+// Erf returns the error function of f.
+//
+// Special cases are:
+//
+//	Erf(+Inf) = 1
+//	Erf(-Inf) = -1
+//	Erf(±0) = ±0
+//	Erf(NaN) = NaN
+//
+// Erf rounds to ±1 for |f| above about 1.52.
+func Erf(f Float8) Float8 {
+	if f.IsZero() {
+		return f
+	}
+
+	f32 := f.ToFloat32()
+	result := float32(math.Erf(float64(f32)))
+	return ToFloat8(result)
+}
+
+// Erfc returns the complementary error function of f, 1 - Erf(f).
+//
+// Special cases are:
+//
+//	Erfc(+Inf) = 0
+//	Erfc(-Inf) = 2
+//	Erfc(NaN) = NaN
+func Erfc(f Float8) Float8 {
+	f32 := f.ToFloat32()
+	result := float32(math.Erfc(float64(f32)))
+	return ToFloat8(result)
+}
+
 // Sin returns the sine of f (in radians).
 //
 // Special cases are:
@@ -204,6 +368,26 @@ func Tan(f Float8) Float8 {
 	return ToFloat8(result)
 }
 
+// Tanh returns the hyperbolic tangent of f.
+//
+// Special cases are:
+//
+//	Tanh(±0) = ±0
+//	Tanh(±Inf) = ±1
+//	Tanh(NaN) = NaN
+//
+// For finite x, the result lies in [-1, 1] and is rounded to the nearest
+// representable Float8 value.
+func Tanh(f Float8) Float8 {
+	if f.IsZero() {
+		return f // Preserve sign of zero
+	}
+
+	f32 := f.ToFloat32()
+	result := float32(math.Tanh(float64(f32)))
+	return ToFloat8(result)
+}
+
 // Floor returns the greatest integer value less than or equal to f.
 //
 // Special cases are:
@@ -286,6 +470,86 @@ func Trunc(f Float8) Float8 {
 	return ToFloat8(result)
 }
 
+// Method forms of the functions above, for chaining such as x.Sqrt().Floor().
+// Each delegates to the function of the same name.
+
+// Sqrt returns the square root of f; see Sqrt.
+func (f Float8) Sqrt() Float8 {
+	return Sqrt(f)
+}
+
+// Floor returns the greatest integer value less than or equal to f; see Floor.
+func (f Float8) Floor() Float8 {
+	return Floor(f)
+}
+
+// Ceil returns the least integer value greater than or equal to f; see Ceil.
+func (f Float8) Ceil() Float8 {
+	return Ceil(f)
+}
+
+// Round returns f rounded to the nearest integer; see Round.
+func (f Float8) Round() Float8 {
+	return Round(f)
+}
+
+// Trunc returns the integer part of f; see Trunc.
+func (f Float8) Trunc() Float8 {
+	return Trunc(f)
+}
+
+// Clamp restricts f to the range [min, max]; see Clamp.
+func (f Float8) Clamp(min, max Float8) Float8 {
+	return Clamp(f, min, max)
+}
+
+// RoundToMultiple returns the multiple of step nearest to f, rounding ties
+// away from zero.
+//
+// The sign of step is ignored. If step is zero, RoundToMultiple returns f
+// unchanged. The quotient f/step and the product are computed in float64,
+// so the only rounding is the final conversion to Float8.
+//
+// Special cases are:
+//
+//	RoundToMultiple(±Inf, step) = ±Inf for finite nonzero step
+//	RoundToMultiple(f, ±Inf) = NaN
+//	RoundToMultiple(NaN, step) = RoundToMultiple(f, NaN) = NaN
+func RoundToMultiple(f, step Float8) Float8 {
+	return toMultiple(f, step, math.Round)
+}
+
+// FloorToMultiple returns the greatest multiple of step less than or equal
+// to f. It treats step and special cases like RoundToMultiple.
+func FloorToMultiple(f, step Float8) Float8 {
+	return toMultiple(f, step, math.Floor)
+}
+
+// CeilToMultiple returns the least multiple of step greater than or equal
+// to f. It treats step and special cases like RoundToMultiple.
+func CeilToMultiple(f, step Float8) Float8 {
+	return toMultiple(f, step, math.Ceil)
+}
+
+// toMultiple returns |step| × round(f / |step|) for the given integer
+// rounding function.
+func toMultiple(f, step Float8, round func(float64) float64) Float8 {
+	if f.IsNaN() {
+		return f
+	}
+	if step.IsNaN() {
+		return step
+	}
+	if step.IsZero() {
+		return f
+	}
+
+	x := float64(f.ToFloat32())
+	m := float64(step.Abs().ToFloat32())
+	result := float32(round(x/m) * m)
+	return ToFloat8(result)
+}
+
 // Fmod returns the floating-point remainder of x/y.
 //
 // The result has the same sign as x and magnitude less than the magnitude of y.
@@ -326,6 +590,253 @@ func Fmod(x, y Float8) Float8 {
 	return ToFloat8(result)
 }
 
+// DivMod returns the truncated quotient and the remainder of a/b together,
+// with quotient = Trunc(a/b) and remainder = a - quotient*b. The remainder has
+// the sign of a, like Fmod.
+//
+// Both results are derived from one exact float64 remainder, so they always
+// agree: a = quotient*b + remainder holds exactly whenever the quotient is
+// representable, and otherwise within the rounding of quotient to Float8.
+//
+// Special cases are:
+//
+//	DivMod(a, ±0) = NaN, NaN
+//	DivMod(±Inf, b) = NaN, NaN
+//	DivMod(a, ±Inf) = ±0, a for finite a, with the quotient signed as a/b
+//	DivMod(NaN, b) = DivMod(a, NaN) = NaN, NaN
+//
+// Unlike Fmod, which returns 0 for a zero divisor for compatibility, DivMod
+// reports the undefined cases as NaN.
+func DivMod(a, b Float8) (quotient, remainder Float8) {
+	if a.IsNaN() || b.IsNaN() || b.IsZero() || a.IsInf() {
+		return NaN, NaN
+	}
+
+	x, y := a.ToFloat64(), b.ToFloat64()
+	r := x
+	if !b.IsInf() {
+		r = math.Mod(x, y)
+	}
+	// x - r is an exact multiple of y, so the division is exact; the sign is
+	// set explicitly so that zero quotients keep the sign of a/b
+	q := math.Abs((x - r) / y)
+	if math.Signbit(x) != math.Signbit(y) {
+		q = -q
+	}
+	return ToFloat8(float32(q)), ToFloat8(float32(r))
+}
+
+// Logb returns the unbiased binary exponent of f as an integer-valued
+// Float8, so that |f| = m × 2^Logb(f) with 1 <= m < 2.
+//
+// Special cases are:
+//
+//	Logb(±Inf) = +Inf
+//	Logb(±0) = -Inf
+//	Logb(NaN) = NaN
+//
+// Finite results range from -9 for SmallestPositive to 8 for MaxValue.
+func Logb(f Float8) Float8 {
+	switch {
+	case f.IsZero():
+		return NegativeInfinity
+	case f.IsInf():
+		return PositiveInfinity
+	case f.IsNaN():
+		return f
+	}
+	return FromInt(Ilogb(f))
+}
+
+// Ilogb returns the unbiased binary exponent of f as an int, read directly
+// from the exponent field for normal values and from the position of the
+// leading mantissa bit for subnormals.
+//
+// Special cases are:
+//
+//	Ilogb(±Inf) = math.MaxInt32
+//	Ilogb(0) = math.MinInt32
+//	Ilogb(NaN) = math.MaxInt32
+func Ilogb(f Float8) int {
+	switch {
+	case f.IsZero():
+		return math.MinInt32
+	case f.IsInf() || f.IsNaN():
+		return math.MaxInt32
+	case f.IsSubnormal():
+		// A subnormal is mant × 2^(1-ExponentBias-MantissaLen), and mant's
+		// leading bit adds bits.Len8(mant)-1 to that exponent
+		return bits.Len8(uint8(f&MantissaMask)) - ExponentBias - MantissaLen
+	}
+	return int(f&ExponentMask)>>MantissaLen - ExponentBias
+}
+
+// Scalb returns f × 2^n, computed by adjusting the exponent field directly.
+//
+// Special cases are:
+//
+//	Scalb(±0, n) = ±0
+//	Scalb(±Inf, n) = ±Inf
+//	Scalb(NaN, n) = NaN
+//
+// Results beyond the largest finite magnitude overflow to ±Inf. Results below the
+// smallest normal magnitude become subnormals (or signed zero when FlushToZero is
+// set), rounding like ToFloat8, and underflow to signed zero once they drop below
+// the smallest subnormal.
+//
+// Unlike Mul(f, ToFloat8(2^n)), Scalb does not require 2^n itself to be representable.
+func Scalb(f Float8, n int) Float8 {
+	if f.IsZero() || f.IsInf() || f.IsNaN() {
+		return f
+	}
+
+	// Any shift beyond the format's dynamic range saturates the same way,
+	// so clamp n to keep the exponent arithmetic small
+	n = max(-32, min(n, 32))
+
+	sign := f & SignMask
+	exp := int((f & ExponentMask) >> MantissaLen)
+	sig := int(f & MantissaMask)
+
+	// Normalize to a significand with an explicit leading bit
+	if exp == 0 {
+		exp = 1
+		for sig < 1<<MantissaLen {
+			sig <<= 1
+			exp--
+		}
+	} else {
+		sig |= 1 << MantissaLen
+	}
+
+	exp += n
+
+	if exp <= 0 {
+		// Shift into the subnormal range, rounding up on the last bit shifted out
+		shift := 1 - exp
+		sub := 0
+		if shift <= MantissaLen+1 {
+			sub = sig >> shift
+			if (sig>>(shift-1))&1 != 0 {
+				sub++
+			}
+		}
+		if sub == 0 || (FlushToZero && sub < 1<<MantissaLen) {
+			return sign // Signed zero
+		}
+		return sign | Float8(sub)
+	}
+
+	// The all-1s exponent only encodes finite values for mantissas 001-110:
+	// 480 overflows, while 256 goes to its nearest finite neighbor, 240
+	mant := sig &^ (1 << MantissaLen)
+	if exp > ExponentMax || (exp == ExponentMax && mant == MantissaMask) {
+		return sign | PositiveInfinity
+	}
+	if exp == ExponentMax && mant == 0 {
+		return sign | 0x77
+	}
+
+	return sign | Float8(exp<<MantissaLen|mant)
+}
+
+// NextAfter returns the next representable Float8 value after f towards g.
+//
+// Special cases are:
+//
+//	NextAfter(f, f) = f
+//	NextAfter(NaN, g) = NaN
+//	NextAfter(f, NaN) = NaN
+//	NextAfter(±0, g) = ±SmallestPositive, with the sign of g
+//
+// The walk skips the infinity encoding 0x78 between 240 and 288, so stepping
+// up from MaxValue gives +Inf and stepping down from +Inf gives MaxValue.
+func NextAfter(f, g Float8) Float8 {
+	switch {
+	case f.IsNaN() || g.IsNaN():
+		return NaN
+	case Equal(f, g):
+		return f
+	case f.IsZero():
+		if Less(g, f) {
+			return SignMask | SmallestPositive
+		}
+		return SmallestPositive
+	}
+
+	sign := f & SignMask
+	mag := f &^ SignMask
+	if Less(f, g) == (sign == 0) {
+		// Move away from zero
+		switch mag {
+		case MaxValue:
+			return sign | PositiveInfinity
+		case PositiveInfinity - 1:
+			return sign | (PositiveInfinity + 1)
+		}
+		return sign | (mag + 1)
+	}
+
+	// Move towards zero
+	switch mag {
+	case PositiveInfinity:
+		return sign | MaxValue
+	case PositiveInfinity + 1:
+		return sign | (PositiveInfinity - 1)
+	}
+	return sign | (mag - 1)
+}
+
+// NextUp returns the least Float8 value greater than f, the IEEE 754 nextUp
+// operation. It is NextAfter(f, +Inf) except at the ends of the range.
+//
+// Special cases are:
+//
+//	NextUp(±0) = SmallestPositive
+//	NextUp(-SmallestPositive) = -0
+//	NextUp(MaxValue) = +Inf
+//	NextUp(+Inf) = +Inf
+//	NextUp(-Inf) = MinValue
+//	NextUp(NaN) = NaN
+func NextUp(f Float8) Float8 {
+	if f.IsNaN() || f == PositiveInfinity {
+		return f
+	}
+	return NextAfter(f, PositiveInfinity)
+}
+
+// NextDown returns the greatest Float8 value less than f, the IEEE 754
+// nextDown operation. NextDown(f) is -NextUp(-f), so NextDown(±0) is
+// -SmallestPositive and NextDown(-Inf) is -Inf.
+func NextDown(f Float8) Float8 {
+	if f.IsNaN() || f == NegativeInfinity {
+		return f
+	}
+	return NextAfter(f, NegativeInfinity)
+}
+
+// ULPDistance returns the number of representable values separating a and b:
+// 0 if they are equal, positive if a < b, and negative if a > b.
+//
+// Signed zeros are the same value, so ULPDistance(-0, +0) = 0, and
+// ULPDistance(MaxValue, +Inf) = 1. If either argument is NaN, ULPDistance
+// returns math.MaxInt.
+func ULPDistance(a, b Float8) int {
+	if a.IsNaN() || b.IsNaN() {
+		return math.MaxInt
+	}
+	return ulpPosition(b) - ulpPosition(a)
+}
+
+// ulpPosition returns the signed position of a non-NaN value on the number
+// line of representable values, with both zeros at 0.
+func ulpPosition(f Float8) int {
+	if f&SignMask != 0 {
+		return -magnitudeRank(f)
+	}
+	return magnitudeRank(f)
+}
+
 // Constants as Float8 values
 var (
 	E      = ToFloat8(2.718281828459045)  // Euler's number
@@ -342,7 +853,8 @@ var (
 
 // Utility functions
 
-// Clamp restricts f to the range [min, max]
+// Clamp restricts f to the range [min, max].
+// If f is NaN, Clamp returns NaN.
 func Clamp(f, min, max Float8) Float8 {
 	if Less(f, min) {
 		return min
@@ -353,6 +865,18 @@ func Clamp(f, min, max Float8) Float8 {
 	return f
 }
 
+// Clamp01 restricts f to the range [0, 1], as Clamp(f, PositiveZero, One())
+// does. NaN is returned unchanged, and -0 stays -0 since it is not less than 0.
+func Clamp01(f Float8) Float8 {
+	return Clamp(f, PositiveZero, One())
+}
+
+// Saturate is an alias for Clamp01, using the name common in shading
+// languages.
+func Saturate(f Float8) Float8 {
+	return Clamp01(f)
+}
+
 // Lerp performs linear interpolation between a and b by factor t
 func Lerp(a, b, t Float8) Float8 {
 	// lerp(a, b, t) = a + t * (b - a)
@@ -361,6 +885,106 @@ func Lerp(a, b, t Float8) Float8 {
 	return Add(a, scaled)
 }
 
+// FMA returns a*b + c computed with a single rounding. The product and sum
+// are exact in float64, and the result is rounded to Float8 once, so FMA can
+// differ from Add(Mul(a, b), c), which rounds the product first.
+//
+// Special cases follow IEEE 754: FMA returns NaN if ±0 is multiplied by ±Inf
+// or if the product is an infinity of the opposite sign to an infinite c. If
+// any argument is NaN, FMA returns the first NaN argument.
+func FMA(a, b, c Float8) Float8 {
+	switch {
+	case a.IsNaN():
+		return a
+	case b.IsNaN():
+		return b
+	case c.IsNaN():
+		return c
+	}
+	r := float64(a.ToFloat32())*float64(b.ToFloat32()) + float64(c.ToFloat32())
+	return roundFloat32(float32(r), FlushToZero)
+}
+
+// Remap maps f from the range [inMin, inMax] to [outMin, outMax], computing
+// outMin + (f-inMin)/(inMax-inMin)*(outMax-outMin) in float64 and rounding
+// once. Values outside the input range extrapolate linearly; use
+// RemapClamped to stay within the output range.
+//
+// Either range may be reversed. If inMin == inMax, Remap returns outMin.
+// If any argument is NaN, Remap returns NaN.
+func Remap(f, inMin, inMax, outMin, outMax Float8) Float8 {
+	return remap(f, inMin, inMax, outMin, outMax, false)
+}
+
+// RemapClamped is like Remap but clamps the result to the output range,
+// whichever of outMin and outMax is larger.
+func RemapClamped(f, inMin, inMax, outMin, outMax Float8) Float8 {
+	return remap(f, inMin, inMax, outMin, outMax, true)
+}
+
+// remap implements Remap and RemapClamped.
+func remap(f, inMin, inMax, outMin, outMax Float8, clamp bool) Float8 {
+	for _, v := range [...]Float8{f, inMin, inMax, outMin, outMax} {
+		if v.IsNaN() {
+			return v
+		}
+	}
+	if Equal(inMin, inMax) {
+		return outMin
+	}
+
+	x := float64(f.ToFloat32())
+	i0, i1 := float64(inMin.ToFloat32()), float64(inMax.ToFloat32())
+	o0, o1 := float64(outMin.ToFloat32()), float64(outMax.ToFloat32())
+	result := o0 + (x-i0)/(i1-i0)*(o1-o0)
+	if clamp {
+		result = max(min(o0, o1), min(max(o0, o1), result))
+	}
+	return ToFloat8(float32(result))
+}
+
+// Step returns 0 if f < edge and 1 otherwise. If f or edge is NaN, Step
+// returns NaN.
+func Step(edge, f Float8) Float8 {
+	if f.IsNaN() {
+		return f
+	}
+	if edge.IsNaN() {
+		return edge
+	}
+	if Less(f, edge) {
+		return PositiveZero
+	}
+	return One()
+}
+
+// Smoothstep returns the Hermite interpolation 3t² - 2t³ of
+// t = (f - edge0) / (edge1 - edge0) clamped to [0, 1], computed in float32
+// and rounded once. It is 0 for f <= edge0, 1 for f >= edge1, and rises
+// smoothly in between.
+//
+// If edge0 > edge1 the curve is mirrored, falling from 1 at edge1 to 0 at
+// edge0. If edge0 == edge1, Smoothstep is Step(edge0, f). If any argument is
+// NaN, or t is undefined because both f - edge0 and edge1 - edge0 are
+// infinite, Smoothstep returns NaN.
+func Smoothstep(edge0, edge1, f Float8) Float8 {
+	if edge0.IsNaN() || edge1.IsNaN() || f.IsNaN() {
+		return NaN
+	}
+	if Equal(edge0, edge1) {
+		return Step(edge0, f)
+	}
+
+	e0, e1 := float64(edge0.ToFloat32()), float64(edge1.ToFloat32())
+	t := (float64(f.ToFloat32()) - e0) / (e1 - e0)
+	if math.IsNaN(t) {
+		return NaN
+	}
+	t = max(0, min(1, t))
+	result := float32(t * t * (3 - 2*t))
+	return ToFloat8(result)
+}
+
 // Sign returns -1, 0, or 1 depending on the sign of f
 func Sign(f Float8) Float8 {
 	sign := f.Sign()
@@ -117,6 +117,67 @@ func TestMathFunctions(t *testing.T) {
 		}
 	})
 
+	t.Run("Exp2", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    Float8
+			expected Float8
+		}{
+			{"exp2(0)", PositiveZero, One()},
+			{"exp2(-0)", NegativeZero, One()},
+			{"exp2(3)", FromInt(3), FromInt(8)},
+			{"exp2(-2)", FromInt(-2), ToFloat8(0.25)},
+			{"exp2(0.5)", ToFloat8(0.5), Sqrt2},
+			{"exp2(8) rounds to nearest finite", FromInt(8), Float8(0x77)},
+			{"exp2(9) overflows", FromInt(9), PositiveInfinity},
+			{"exp2(-11) underflows", FromInt(-11), PositiveZero},
+			{"exp2(inf)", PositiveInfinity, PositiveInfinity},
+			{"exp2(-inf)", NegativeInfinity, PositiveZero},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result := Exp2(tt.input)
+				if result != tt.expected {
+					t.Errorf("Exp2(%v) = %v, want %v", tt.input, result, tt.expected)
+				}
+			})
+		}
+
+		if result := Exp2(NaN); !result.IsNaN() {
+			t.Errorf("Exp2(NaN) = %v, want NaN", result)
+		}
+	})
+
+	t.Run("Pow10", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    Float8
+			expected Float8
+		}{
+			{"pow10(0)", PositiveZero, One()},
+			{"pow10(1)", One(), FromInt(10)},
+			{"pow10(2)", FromInt(2), ToFloat8(100)},
+			{"pow10(-1)", FromInt(-1), ToFloat8(0.1)},
+			{"pow10(3) overflows", FromInt(3), PositiveInfinity},
+			{"pow10(inf)", PositiveInfinity, PositiveInfinity},
+			{"pow10(-inf)", NegativeInfinity, PositiveZero},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result := Pow10(tt.input)
+				if result != tt.expected {
+					t.Errorf("Pow10(%v) = %v, want %v", tt.input, result, tt.expected)
+				}
+			})
+		}
+
+		if result := Pow10(NaN); !result.IsNaN() {
+			t.Errorf("Pow10(NaN) = %v, want NaN", result)
+		}
+	})
+
 	t.Run("Log", func(t *testing.T) {
 		tests := []struct {
 			name     string
@@ -364,3 +425,805 @@ func TestMathFunctions(t *testing.T) {
 		}
 	})
 }
+
+func TestGamma(t *testing.T) {
+	tests := []struct {
+		name string
+		f    Float8
+		want Float8
+	}{
+		{"gamma(1) = 0!", One(), One()},
+		{"gamma(2) = 1!", FromInt(2), One()},
+		{"gamma(3) = 2!", FromInt(3), FromInt(2)},
+		{"gamma(4) = 3!", FromInt(4), FromInt(6)},
+		{"gamma(5) = 4!", FromInt(5), FromInt(24)},
+		{"gamma(6) = 5!", FromInt(6), FromInt(120)},
+		{"gamma(7) overflows", FromInt(7), PositiveInfinity},
+		{"gamma(0.5) = sqrt(pi)", ToFloat8(0.5), SqrtPi},
+		{"gamma(-0.5)", ToFloat8(-0.5), ToFloat8(-3.5449077)},
+		{"positive zero", PositiveZero, PositiveInfinity},
+		{"negative zero", NegativeZero, NegativeInfinity},
+		{"infinity", PositiveInfinity, PositiveInfinity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Gamma(tt.f); got != tt.want {
+				t.Errorf("Gamma(%v) = %v, want %v", tt.f, got, tt.want)
+			}
+		})
+	}
+
+	for _, f := range []Float8{FromInt(-1), FromInt(-3), NegativeInfinity, NaN} {
+		if got := Gamma(f); !got.IsNaN() {
+			t.Errorf("Gamma(%v) = %v, want NaN", f, got)
+		}
+	}
+}
+
+func TestLgamma(t *testing.T) {
+	tests := []struct {
+		name     string
+		f        Float8
+		want     Float8
+		wantSign int
+	}{
+		{"lgamma(1)", One(), PositiveZero, 1},
+		{"lgamma(2)", FromInt(2), PositiveZero, 1},
+		{"lgamma(3)", FromInt(3), Ln2, 1},
+		{"lgamma(10)", FromInt(10), ToFloat8(12.801827), 1},
+		{"lgamma(-0.5)", ToFloat8(-0.5), ToFloat8(1.2655121), -1},
+		{"zero", PositiveZero, PositiveInfinity, 1},
+		{"negative integer", FromInt(-2), PositiveInfinity, 1},
+		{"infinity", PositiveInfinity, PositiveInfinity, 1},
+		{"negative infinity", NegativeInfinity, NegativeInfinity, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, sign := Lgamma(tt.f)
+			if got != tt.want || sign != tt.wantSign {
+				t.Errorf("Lgamma(%v) = %v, %d, want %v, %d", tt.f, got, sign, tt.want, tt.wantSign)
+			}
+		})
+	}
+
+	if got, _ := Lgamma(NaN); !got.IsNaN() {
+		t.Errorf("Lgamma(NaN) = %v, want NaN", got)
+	}
+}
+
+func TestErf(t *testing.T) {
+	tests := []struct {
+		name string
+		f    Float8
+		want Float8
+	}{
+		{"positive zero", PositiveZero, PositiveZero},
+		{"negative zero", NegativeZero, NegativeZero},
+		{"erf(0.5)", ToFloat8(0.5), ToFloat8(0.5205)},
+		{"erf(1)", One(), ToFloat8(0.8427)},
+		{"erf(-1)", FromInt(-1), ToFloat8(-0.8427)},
+		{"saturates", FromInt(3), One()},
+		{"saturates negative", FromInt(-3), FromInt(-1)},
+		{"infinity", PositiveInfinity, One()},
+		{"negative infinity", NegativeInfinity, FromInt(-1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Erf(tt.f); got != tt.want {
+				t.Errorf("Erf(%v) = %v, want %v", tt.f, got, tt.want)
+			}
+		})
+	}
+
+	if got := Erf(NaN); !got.IsNaN() {
+		t.Errorf("Erf(NaN) = %v, want NaN", got)
+	}
+}
+
+func TestErfc(t *testing.T) {
+	tests := []struct {
+		name string
+		f    Float8
+		want Float8
+	}{
+		{"zero", PositiveZero, One()},
+		{"erfc(1)", One(), ToFloat8(0.1573)},
+		{"erfc(-1)", FromInt(-1), ToFloat8(1.8427)},
+		{"large rounds to zero", FromInt(4), PositiveZero},
+		{"infinity", PositiveInfinity, PositiveZero},
+		{"negative infinity", NegativeInfinity, FromInt(2)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Erfc(tt.f); got != tt.want {
+				t.Errorf("Erfc(%v) = %v, want %v", tt.f, got, tt.want)
+			}
+		})
+	}
+
+	if got := Erfc(NaN); !got.IsNaN() {
+		t.Errorf("Erfc(NaN) = %v, want NaN", got)
+	}
+
+	// Erf and Erfc are complementary within 8-bit tolerance
+	for _, f := range AllFiniteValues() {
+		sum := Erf(f).ToFloat32() + Erfc(f).ToFloat32()
+		if math.Abs(float64(sum)-1) > 0.07 {
+			t.Errorf("Erf(%v) + Erfc(%v) = %v, want 1", f, f, sum)
+		}
+	}
+}
+
+func TestToMultiple(t *testing.T) {
+	half := ToFloat8(0.5)
+	tests := []struct {
+		name               string
+		f, step            Float8
+		round, floor, ceil Float8
+	}{
+		{"snaps to half", ToFloat8(1.3), half, ToFloat8(1.5), One(), ToFloat8(1.5)},
+		{"already a multiple", ToFloat8(1.5), half, ToFloat8(1.5), ToFloat8(1.5), ToFloat8(1.5)},
+		{"tie rounds away from zero", ToFloat8(1.25), half, ToFloat8(1.5), One(), ToFloat8(1.5)},
+		{"negative", ToFloat8(-1.3), half, ToFloat8(-1.5), ToFloat8(-1.5), FromInt(-1)},
+		{"negative tie", ToFloat8(-1.25), half, ToFloat8(-1.5), ToFloat8(-1.5), FromInt(-1)},
+		{"negative step", ToFloat8(1.3), ToFloat8(-0.5), ToFloat8(1.5), One(), ToFloat8(1.5)},
+		{"step of four", FromInt(13), FromInt(4), FromInt(12), FromInt(12), FromInt(16)},
+		{"rounds toward negative zero", ToFloat8(-0.2), half, NegativeZero, ToFloat8(-0.5), NegativeZero},
+		{"zero step", ToFloat8(1.3), PositiveZero, ToFloat8(1.3), ToFloat8(1.3), ToFloat8(1.3)},
+		{"infinity", PositiveInfinity, half, PositiveInfinity, PositiveInfinity, PositiveInfinity},
+		{"overflow", MaxValue, FromInt(160), PositiveInfinity, FromInt(320), PositiveInfinity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RoundToMultiple(tt.f, tt.step); got != tt.round {
+				t.Errorf("RoundToMultiple(%v, %v) = %v (0x%02x), want %v", tt.f, tt.step, got, uint8(got), tt.round)
+			}
+			if got := FloorToMultiple(tt.f, tt.step); got != tt.floor {
+				t.Errorf("FloorToMultiple(%v, %v) = %v (0x%02x), want %v", tt.f, tt.step, got, uint8(got), tt.floor)
+			}
+			if got := CeilToMultiple(tt.f, tt.step); got != tt.ceil {
+				t.Errorf("CeilToMultiple(%v, %v) = %v (0x%02x), want %v", tt.f, tt.step, got, uint8(got), tt.ceil)
+			}
+		})
+	}
+
+	for _, args := range [][2]Float8{{NaN, half}, {One(), NaN}, {One(), PositiveInfinity}} {
+		if got := RoundToMultiple(args[0], args[1]); !got.IsNaN() {
+			t.Errorf("RoundToMultiple(%v, %v) = %v, want NaN", args[0], args[1], got)
+		}
+	}
+}
+
+func TestRemap(t *testing.T) {
+	tests := []struct {
+		name                            string
+		f, inMin, inMax, outMin, outMax Float8
+		want, wantClamped               Float8
+	}{
+		// 255 is not representable and rounds to 240, so the midpoint is 120
+		{"unit to byte", ToFloat8(0.5), PositiveZero, One(), PositiveZero, FromInt(255), FromInt(120), FromInt(120)},
+		{"unit to byte lower", PositiveZero, PositiveZero, One(), PositiveZero, FromInt(255), PositiveZero, PositiveZero},
+		{"unit to byte upper", One(), PositiveZero, One(), PositiveZero, FromInt(255), FromInt(255), FromInt(255)},
+		{"reversed output", ToFloat8(0.25), PositiveZero, One(), FromInt(8), PositiveZero, FromInt(6), FromInt(6)},
+		{"reversed input", FromInt(3), FromInt(4), PositiveZero, PositiveZero, One(), ToFloat8(0.25), ToFloat8(0.25)},
+		{"to symmetric range", ToFloat8(0.75), PositiveZero, One(), FromInt(-1), One(), ToFloat8(0.5), ToFloat8(0.5)},
+		{"extrapolates", FromInt(2), PositiveZero, One(), PositiveZero, FromInt(10), FromInt(20), FromInt(10)},
+		{"extrapolates reversed", FromInt(-1), PositiveZero, One(), FromInt(8), PositiveZero, FromInt(16), FromInt(8)},
+		{"degenerate input range", FromInt(5), One(), One(), FromInt(3), FromInt(7), FromInt(3), FromInt(3)},
+		{"overflow", MaxValue, PositiveZero, One(), PositiveZero, FromInt(2), PositiveInfinity, FromInt(2)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Remap(tt.f, tt.inMin, tt.inMax, tt.outMin, tt.outMax); got != tt.want {
+				t.Errorf("Remap(%v, %v, %v, %v, %v) = %v, want %v", tt.f, tt.inMin, tt.inMax, tt.outMin, tt.outMax, got, tt.want)
+			}
+			if got := RemapClamped(tt.f, tt.inMin, tt.inMax, tt.outMin, tt.outMax); got != tt.wantClamped {
+				t.Errorf("RemapClamped(%v, %v, %v, %v, %v) = %v, want %v", tt.f, tt.inMin, tt.inMax, tt.outMin, tt.outMax, got, tt.wantClamped)
+			}
+		})
+	}
+
+	if got := Remap(NaN, PositiveZero, One(), PositiveZero, One()); !got.IsNaN() {
+		t.Errorf("Remap(NaN, ...) = %v, want NaN", got)
+	}
+	if got := RemapClamped(One(), PositiveZero, One(), NaN, One()); !got.IsNaN() {
+		t.Errorf("RemapClamped(..., NaN, 1) = %v, want NaN", got)
+	}
+}
+
+func TestStep(t *testing.T) {
+	tests := []struct {
+		name    string
+		edge, f Float8
+		want    Float8
+	}{
+		{"below", One(), ToFloat8(0.9375), PositiveZero},
+		{"at edge", One(), One(), One()},
+		{"above", One(), ToFloat8(1.125), One()},
+		{"signed zeros are equal", PositiveZero, NegativeZero, One()},
+		{"negative infinity", PositiveZero, NegativeInfinity, PositiveZero},
+		{"infinity", MaxValue, PositiveInfinity, One()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Step(tt.edge, tt.f); got != tt.want {
+				t.Errorf("Step(%v, %v) = %v, want %v", tt.edge, tt.f, got, tt.want)
+			}
+		})
+	}
+
+	if got := Step(NaN, One()); !got.IsNaN() {
+		t.Errorf("Step(NaN, 1) = %v, want NaN", got)
+	}
+	if got := Step(One(), NaN); !got.IsNaN() {
+		t.Errorf("Step(1, NaN) = %v, want NaN", got)
+	}
+}
+
+func TestSmoothstep(t *testing.T) {
+	tests := []struct {
+		name         string
+		edge0, edge1 Float8
+		f            Float8
+		want         Float8
+	}{
+		{"midpoint", PositiveZero, One(), ToFloat8(0.5), ToFloat8(0.5)},
+		{"quarter", PositiveZero, One(), ToFloat8(0.25), ToFloat8(0.15625)},
+		{"lower edge", PositiveZero, One(), PositiveZero, PositiveZero},
+		{"upper edge", PositiveZero, One(), One(), One()},
+		{"clamped below", PositiveZero, One(), FromInt(-3), PositiveZero},
+		{"clamped above", PositiveZero, One(), FromInt(3), One()},
+		{"wider range", FromInt(2), FromInt(6), FromInt(4), ToFloat8(0.5)},
+		{"mirrored", One(), PositiveZero, ToFloat8(0.25), ToFloat8(0.84375)},
+		{"mirrored clamped", One(), PositiveZero, FromInt(2), PositiveZero},
+		{"equal edges below", One(), One(), ToFloat8(0.5), PositiveZero},
+		{"equal edges at", One(), One(), One(), One()},
+		{"infinite input", PositiveZero, One(), PositiveInfinity, One()},
+		{"infinite upper edge", PositiveZero, PositiveInfinity, MaxValue, PositiveZero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Smoothstep(tt.edge0, tt.edge1, tt.f); got != tt.want {
+				t.Errorf("Smoothstep(%v, %v, %v) = %v, want %v", tt.edge0, tt.edge1, tt.f, got, tt.want)
+			}
+		})
+	}
+
+	for _, args := range [][3]Float8{
+		{NaN, One(), PositiveZero},
+		{PositiveZero, NaN, PositiveZero},
+		{PositiveZero, One(), NaN},
+		{NegativeInfinity, PositiveZero, FromInt(-2)},
+	} {
+		if got := Smoothstep(args[0], args[1], args[2]); !got.IsNaN() {
+			t.Errorf("Smoothstep(%v, %v, %v) = %v, want NaN", args[0], args[1], args[2], got)
+		}
+	}
+}
+
+func TestDivMod(t *testing.T) {
+	tests := []struct {
+		name         string
+		a, b         Float8
+		wantQ, wantR Float8
+	}{
+		{"seven by two", FromInt(7), FromInt(2), FromInt(3), One()},
+		{"exact", FromInt(6), FromInt(3), FromInt(2), PositiveZero},
+		{"negative dividend", FromInt(-7), FromInt(2), FromInt(-3), FromInt(-1)},
+		{"negative divisor", FromInt(7), FromInt(-2), FromInt(-3), One()},
+		{"both negative", FromInt(-7), FromInt(-2), FromInt(3), FromInt(-1)},
+		{"fractional", ToFloat8(5.5), ToFloat8(1.5), FromInt(3), One()},
+		{"smaller dividend", ToFloat8(0.5), FromInt(3), PositiveZero, ToFloat8(0.5)},
+		{"smaller negative dividend", ToFloat8(-0.5), FromInt(3), NegativeZero, ToFloat8(-0.5)},
+		{"zero dividend", PositiveZero, FromInt(3), PositiveZero, PositiveZero},
+		{"negative zero dividend", NegativeZero, FromInt(3), NegativeZero, NegativeZero},
+		{"unrepresentable quotient", FromInt(448), FromInt(3), FromInt(144), FromInt(1)},
+		{"infinite divisor", FromInt(5), PositiveInfinity, PositiveZero, FromInt(5)},
+		{"negative infinite divisor", FromInt(5), NegativeInfinity, NegativeZero, FromInt(5)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, r := DivMod(tt.a, tt.b)
+			if q != tt.wantQ || r != tt.wantR {
+				t.Errorf("DivMod(%v, %v) = (%v, %v), want (%v, %v)", tt.a, tt.b, q, r, tt.wantQ, tt.wantR)
+			}
+		})
+	}
+
+	for _, args := range [][2]Float8{{One(), PositiveZero}, {One(), NegativeZero}, {PositiveInfinity, One()}, {NaN, One()}, {One(), NaN}} {
+		if q, r := DivMod(args[0], args[1]); !q.IsNaN() || !r.IsNaN() {
+			t.Errorf("DivMod(%v, %v) = (%v, %v), want (NaN, NaN)", args[0], args[1], q, r)
+		}
+	}
+
+	// a = quotient*b + remainder, exactly when the quotient is representable
+	values := AllFiniteValues()
+	for _, a := range values {
+		for _, b := range values {
+			if b.IsZero() {
+				continue
+			}
+			q, r := DivMod(a, b)
+			x, y := a.ToFloat64(), b.ToFloat64()
+			exactQ := math.Trunc(x / y)
+			if q.ToFloat64() != exactQ {
+				continue
+			}
+			if got := q.ToFloat64()*y + r.ToFloat64(); got != x {
+				t.Errorf("DivMod(%v, %v) = (%v, %v), but q*b + r = %v", a, b, q, r, got)
+			}
+			if r != Fmod(a, b) && !(r.IsZero() && Fmod(a, b).IsZero()) {
+				t.Errorf("DivMod(%v, %v) remainder = %v, Fmod = %v", a, b, r, Fmod(a, b))
+			}
+		}
+	}
+}
+
+func TestLogb(t *testing.T) {
+	tests := []struct {
+		name string
+		f    Float8
+		want int
+	}{
+		{"eight", ToFloat8(8.0), 3},
+		{"one", One(), 0},
+		{"one and a half", ToFloat8(1.5), 0},
+		{"half", ToFloat8(0.5), -1},
+		{"negative", ToFloat8(-12), 3},
+		{"max value", MaxValue, 8},
+		{"smallest normal", Float8(0x08), -6},
+		{"largest subnormal", Float8(0x07), -7},
+		{"subnormal", Float8(0x03), -8},
+		{"smallest subnormal", SmallestPositive, -9},
+		{"negative subnormal", Float8(0x84), -7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Ilogb(tt.f); got != tt.want {
+				t.Errorf("Ilogb(%v) = %d, want %d", tt.f, got, tt.want)
+			}
+			if got := Logb(tt.f); got != FromInt(tt.want) {
+				t.Errorf("Logb(%v) = %v, want %d", tt.f, got, tt.want)
+			}
+		})
+	}
+
+	special := []struct {
+		name  string
+		f     Float8
+		logb  Float8
+		ilogb int
+	}{
+		{"positive zero", PositiveZero, NegativeInfinity, math.MinInt32},
+		{"negative zero", NegativeZero, NegativeInfinity, math.MinInt32},
+		{"infinity", PositiveInfinity, PositiveInfinity, math.MaxInt32},
+		{"negative infinity", NegativeInfinity, PositiveInfinity, math.MaxInt32},
+	}
+	for _, tt := range special {
+		if got := Logb(tt.f); got != tt.logb {
+			t.Errorf("Logb(%v) = %v, want %v", tt.f, got, tt.logb)
+		}
+		if got := Ilogb(tt.f); got != tt.ilogb {
+			t.Errorf("Ilogb(%v) = %d, want %d", tt.f, got, tt.ilogb)
+		}
+	}
+	if got := Logb(NaN); !got.IsNaN() {
+		t.Errorf("Logb(NaN) = %v, want NaN", got)
+	}
+	if got := Ilogb(NaN); got != math.MaxInt32 {
+		t.Errorf("Ilogb(NaN) = %d, want %d", got, math.MaxInt32)
+	}
+
+	// Ilogb agrees with math.Ilogb on every finite nonzero value
+	for _, f := range AllFiniteValues() {
+		if f.IsZero() {
+			continue
+		}
+		if got, want := Ilogb(f), math.Ilogb(f.ToFloat64()); got != want {
+			t.Errorf("Ilogb(0x%02x) = %d, want %d", uint8(f), got, want)
+		}
+	}
+}
+
+func TestScalb(t *testing.T) {
+	tests := []struct {
+		name string
+		f    Float8
+		n    int
+		want Float8
+	}{
+		{"one times eight", One(), 3, ToFloat8(8.0)},
+		{"one halved", One(), -1, ToFloat8(0.5)},
+		{"negative scaled", ToFloat8(-1.5), 2, ToFloat8(-6.0)},
+		{"identity", ToFloat8(3.0), 0, ToFloat8(3.0)},
+		{"into subnormal", One(), -7, Float8(0x04)},
+		{"smallest subnormal", One(), -9, SmallestPositive},
+		{"subnormal to normal", SmallestPositive, 3, Float8(0x08)},
+		{"underflow to zero", One(), -12, PositiveZero},
+		{"underflow to negative zero", FromInt(-1), -12, NegativeZero},
+		{"large n saturates", One(), 100, PositiveInfinity},
+		{"large negative n saturates", FromInt(-1), 100, NegativeInfinity},
+		{"max int", One(), math.MaxInt, PositiveInfinity},
+		{"min int", One(), math.MinInt, PositiveZero},
+		{"max value overflows", MaxValue, 1, PositiveInfinity},
+		{"256 rounds to nearest finite", One(), 8, Float8(0x77)},
+		{"negative 256 rounds to nearest finite", FromInt(-1), 8, Float8(0xF7)},
+		{"480 overflows", ToFloat8(1.875), 8, PositiveInfinity},
+		{"positive zero", PositiveZero, 5, PositiveZero},
+		{"negative zero", NegativeZero, 5, NegativeZero},
+		{"infinity", PositiveInfinity, -5, PositiveInfinity},
+		{"negative infinity", NegativeInfinity, 5, NegativeInfinity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Scalb(tt.f, tt.n); got != tt.want {
+				t.Errorf("Scalb(%v, %d) = %v (0x%02x), want %v (0x%02x)", tt.f, tt.n, got, uint8(got), tt.want, uint8(tt.want))
+			}
+		})
+	}
+
+	if got := Scalb(NaN, 3); !got.IsNaN() {
+		t.Errorf("Scalb(NaN, 3) = %v, want NaN", got)
+	}
+
+	// Scalb must agree with converting the exact product for every in-range result
+	for i := 0; i < 256; i++ {
+		f := Float8(i)
+		if !f.IsFinite() || f.IsZero() {
+			continue
+		}
+		for n := -12; n <= 12; n++ {
+			exact := math.Ldexp(f.ToFloat64(), n)
+			if math.Abs(exact) > float64(MaxValue.ToFloat32()) {
+				continue
+			}
+			if got, want := Scalb(f, n), ToFloat8(float32(exact)); got != want {
+				t.Errorf("Scalb(0x%02x, %d) = 0x%02x, want 0x%02x", i, n, uint8(got), uint8(want))
+			}
+		}
+	}
+}
+
+func TestPowInt(t *testing.T) {
+	tests := []struct {
+		name string
+		f    Float8
+		n    int
+		want Float8
+	}{
+		{"square", FromInt(3), 2, FromInt(9)},
+		{"cube", FromInt(2), 3, FromInt(8)},
+		{"first power", ToFloat8(1.5), 1, ToFloat8(1.5)},
+		{"zeroth power", FromInt(7), 0, One()},
+		{"zeroth power of zero", PositiveZero, 0, One()},
+		{"zeroth power of infinity", NegativeInfinity, 0, One()},
+		{"zeroth power of NaN", NaN, 0, One()},
+		{"negative base odd", FromInt(-2), 3, FromInt(-8)},
+		{"negative base even", FromInt(-2), 4, FromInt(16)},
+		{"negative exponent", FromInt(2), -3, ToFloat8(0.125)},
+		{"negative base negative odd exponent", FromInt(-2), -1, ToFloat8(-0.5)},
+		{"negative base negative even exponent", FromInt(-4), -2, ToFloat8(0.0625)},
+		{"overflow", FromInt(3), 6, PositiveInfinity},
+		{"negative overflow", FromInt(-3), 7, NegativeInfinity},
+		{"underflow", ToFloat8(0.125), 5, PositiveZero},
+		{"positive zero", PositiveZero, 3, PositiveZero},
+		{"negative zero odd", NegativeZero, 3, NegativeZero},
+		{"negative zero even", NegativeZero, 2, PositiveZero},
+		{"zero negative exponent", PositiveZero, -1, PositiveInfinity},
+		{"negative zero negative odd exponent", NegativeZero, -1, NegativeInfinity},
+		{"infinity negative exponent", PositiveInfinity, -2, PositiveZero},
+		{"one large exponent", One(), math.MaxInt, One()},
+		{"two min int", FromInt(2), math.MinInt, PositiveZero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PowInt(tt.f, tt.n); got != tt.want {
+				t.Errorf("PowInt(%v, %d) = %v (0x%02x), want %v (0x%02x)", tt.f, tt.n, got, uint8(got), tt.want, uint8(tt.want))
+			}
+		})
+	}
+
+	if got := PowInt(NaN, 2); !got.IsNaN() {
+		t.Errorf("PowInt(NaN, 2) = %v, want NaN", got)
+	}
+
+	// PowInt agrees with the exactly rounded power for small exponents
+	for _, f := range AllFiniteValues() {
+		for n := -3; n <= 3; n++ {
+			want := ToFloat8(float32(math.Pow(f.ToFloat64(), float64(n))))
+			if got := PowInt(f, n); got != want {
+				t.Errorf("PowInt(0x%02x, %d) = 0x%02x, want 0x%02x", uint8(f), n, uint8(got), uint8(want))
+			}
+		}
+	}
+}
+
+func TestNextAfter(t *testing.T) {
+	tests := []struct {
+		name     string
+		f, g     Float8
+		expected Float8
+	}{
+		{"one up", One(), FromInt(2), 0x39},
+		{"one down", One(), PositiveZero, 0x37},
+		{"negative one away from zero", FromInt(-1), FromInt(-2), 0xB9},
+		{"negative one towards zero", FromInt(-1), PositiveZero, 0xB7},
+		{"equal", One(), One(), One()},
+		{"zero up", PositiveZero, One(), SmallestPositive},
+		{"zero down", PositiveZero, FromInt(-1), SignMask | SmallestPositive},
+		{"negative zero up", NegativeZero, One(), SmallestPositive},
+		{"smallest subnormal to zero", SmallestPositive, FromInt(-1), PositiveZero},
+		{"subnormal to normal", 0x07, One(), 0x08},
+		{"skip infinity encoding up", 0x77, MaxValue, 0x79},
+		{"skip infinity encoding down", 0x79, PositiveZero, 0x77},
+		{"max to infinity", MaxValue, PositiveInfinity, PositiveInfinity},
+		{"infinity to max", PositiveInfinity, PositiveZero, MaxValue},
+		{"negative infinity to min", NegativeInfinity, PositiveZero, MinValue},
+		{"min to negative infinity", MinValue, NegativeInfinity, NegativeInfinity},
+		{"NaN from", NaN, One(), NaN},
+		{"NaN towards", One(), NaN, NaN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := NextAfter(tt.f, tt.g)
+			if tt.expected.IsNaN() {
+				if !result.IsNaN() {
+					t.Errorf("NextAfter(%v, %v) = %v, want NaN", tt.f, tt.g, result)
+				}
+			} else if result != tt.expected {
+				t.Errorf("NextAfter(%v, %v) = 0x%02x, want 0x%02x", tt.f, tt.g, uint8(result), uint8(tt.expected))
+			}
+		})
+	}
+
+	t.Run("walk is monotonic", func(t *testing.T) {
+		// Walking from -Inf to +Inf must visit every non-NaN value in increasing order.
+		steps := 0
+		for f := NegativeInfinity; f != PositiveInfinity; steps++ {
+			next := NextAfter(f, PositiveInfinity)
+			if !Less(f, next) && !(f.IsZero() && next.IsZero()) {
+				t.Fatalf("NextAfter(%v, +Inf) = %v, not greater", f, next)
+			}
+			f = next
+		}
+		// 2 × 125 nonzero finite values, one zero, and the step onto +Inf
+		if steps != 252 {
+			t.Errorf("walk from -Inf to +Inf took %d steps, want 252", steps)
+		}
+	})
+}
+
+func TestNextUpDown(t *testing.T) {
+	tests := []struct {
+		name     string
+		f        Float8
+		up, down Float8
+	}{
+		{"positive zero", PositiveZero, SmallestPositive, SignMask | SmallestPositive},
+		{"negative zero", NegativeZero, SmallestPositive, SignMask | SmallestPositive},
+		{"smallest subnormal", SmallestPositive, SmallestPositive + 1, PositiveZero},
+		{"negative smallest subnormal", SignMask | SmallestPositive, NegativeZero, SignMask | (SmallestPositive + 1)},
+		{"subnormal to normal", 0x07, 0x08, 0x06},
+		{"one", One(), ToFloat8(1.125), ToFloat8(0.9375)},
+		{"around 256", 0x77, 0x79, 0x76},
+		{"max value", MaxValue, PositiveInfinity, MaxValue - 1},
+		{"min value", MinValue, MinValue - 1, NegativeInfinity},
+		{"positive infinity", PositiveInfinity, PositiveInfinity, MaxValue},
+		{"negative infinity", NegativeInfinity, MinValue, NegativeInfinity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NextUp(tt.f); got != tt.up {
+				t.Errorf("NextUp(%v) = %v (0x%02X), want %v (0x%02X)", tt.f, got, uint8(got), tt.up, uint8(tt.up))
+			}
+			if got := NextDown(tt.f); got != tt.down {
+				t.Errorf("NextDown(%v) = %v (0x%02X), want %v (0x%02X)", tt.f, got, uint8(got), tt.down, uint8(tt.down))
+			}
+		})
+	}
+
+	for _, nan := range []Float8{NaN, NegativeNaN} {
+		if got := NextUp(nan); got != nan {
+			t.Errorf("NextUp(0x%02X) = 0x%02X, want the same NaN", uint8(nan), uint8(got))
+		}
+		if got := NextDown(nan); got != nan {
+			t.Errorf("NextDown(0x%02X) = 0x%02X, want the same NaN", uint8(nan), uint8(got))
+		}
+	}
+
+	t.Run("walk enumerates every value", func(t *testing.T) {
+		// The upward walk visits -0 but steps over +0
+		want := []Float8{NegativeInfinity}
+		for _, f := range AllFiniteValues() {
+			if f != PositiveZero {
+				want = append(want, f)
+			}
+		}
+		want = append(want, PositiveInfinity)
+
+		var up []Float8
+		for f := NegativeInfinity; ; f = NextUp(f) {
+			up = append(up, f)
+			if f == PositiveInfinity || len(up) > 256 {
+				break
+			}
+		}
+		if !equalBits(up, want) {
+			t.Fatalf("NextUp walk = %v, want %v", up, want)
+		}
+
+		// Walking down is the mirror image, visiting +0 instead of -0
+		var down []Float8
+		for f := PositiveInfinity; ; f = NextDown(f) {
+			down = append(down, f)
+			if f == NegativeInfinity || len(down) > 256 {
+				break
+			}
+		}
+		if len(down) != len(want) {
+			t.Fatalf("NextDown walk took %d values, want %d", len(down), len(want))
+		}
+		for i := range down {
+			if down[i] != want[i]^SignMask {
+				t.Fatalf("NextDown walk step %d = %v, want %v", i, down[i], want[i]^SignMask)
+			}
+		}
+	})
+}
+
+func TestMathMethods(t *testing.T) {
+	for _, f := range AllValues() {
+		for _, c := range []struct {
+			name      string
+			got, want Float8
+		}{
+			{"Sqrt", f.Sqrt(), Sqrt(f)},
+			{"Floor", f.Floor(), Floor(f)},
+			{"Ceil", f.Ceil(), Ceil(f)},
+			{"Round", f.Round(), Round(f)},
+			{"Trunc", f.Trunc(), Trunc(f)},
+			{"Clamp", f.Clamp(FromInt(-2), One()), Clamp(f, FromInt(-2), One())},
+		} {
+			if c.got != c.want {
+				t.Errorf("0x%02X.%s() = 0x%02X, want 0x%02X", uint8(f), c.name, uint8(c.got), uint8(c.want))
+			}
+		}
+	}
+
+	if got := ToFloat8(10.5).Clamp(PositiveZero, FromInt(8)).Sqrt().Floor(); got != FromInt(2) {
+		t.Errorf("10.5.Clamp(0, 8).Sqrt().Floor() = %v, want 2", got)
+	}
+}
+
+func TestULPDistance(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     Float8
+		expected int
+	}{
+		{"equal", One(), One(), 0},
+		{"adjacent", FromBits(0x38), FromBits(0x39), 1},
+		{"adjacent reversed", FromBits(0x39), FromBits(0x38), -1},
+		{"signed zeros", NegativeZero, PositiveZero, 0},
+		{"zero crossing", FromBits(0x81), FromBits(0x01), 2},
+		{"zero to smallest", NegativeZero, SmallestPositive, 1},
+		{"negative adjacent", FromBits(0xB9), FromBits(0xB8), 1},
+		{"across infinity encoding", FromBits(0x77), FromBits(0x79), 1},
+		{"max to infinity", MaxValue, PositiveInfinity, 1},
+		{"full range", NegativeInfinity, PositiveInfinity, 252},
+		{"NaN", NaN, One(), math.MaxInt},
+		{"negative NaN", One(), FromBits(0xFF), math.MaxInt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ULPDistance(tt.a, tt.b); got != tt.expected {
+				t.Errorf("ULPDistance(0x%02x, 0x%02x) = %d, want %d", uint8(tt.a), uint8(tt.b), got, tt.expected)
+			}
+		})
+	}
+
+	t.Run("agrees with NextAfter", func(t *testing.T) {
+		for _, f := range AllFiniteValues() {
+			next := NextAfter(f, PositiveInfinity)
+			if got := ULPDistance(f, next); got != 1 {
+				t.Errorf("ULPDistance(0x%02x, NextAfter) = %d, want 1", uint8(f), got)
+			}
+		}
+	})
+}
+
+func TestFMA(t *testing.T) {
+	a, b, c := ToFloat8(1.125), ToFloat8(1.125), FromInt(-1)
+
+	tests := []struct {
+		name    string
+		a, b, c Float8
+		want    Float8
+	}{
+		// 1.125² = 1.265625 rounds to 1.25 on its own, but the exact sum
+		// 0.265625 is a tie that rounds away from zero to 0.28125.
+		{"single rounding", a, b, c, ToFloat8(0.28125)},
+		{"exact", FromInt(3), FromInt(4), FromInt(-2), FromInt(10)},
+		{"negative zero", NegativeZero, One(), NegativeZero, NegativeZero},
+		{"cancels to positive zero", FromInt(2), FromInt(2), FromInt(-4), PositiveZero},
+		{"product exceeds MaxValue", FromInt(32), FromInt(16), FromInt(-224), FromInt(288)},
+		{"overflow", MaxValue, FromInt(2), One(), PositiveInfinity},
+		{"infinite addend", One(), One(), NegativeInfinity, NegativeInfinity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FMA(tt.a, tt.b, tt.c); got != tt.want {
+				t.Errorf("FMA(%v, %v, %v) = %v, want %v", tt.a, tt.b, tt.c, got, tt.want)
+			}
+		})
+	}
+
+	if twice := Add(Mul(a, b), c); twice == FMA(a, b, c) {
+		t.Errorf("Add(Mul(%v, %v), %v) = %v, expected it to differ from FMA", a, b, c, twice)
+	}
+
+	for _, args := range [][3]Float8{
+		{PositiveZero, PositiveInfinity, One()},
+		{PositiveInfinity, One(), NegativeInfinity},
+	} {
+		if got := FMA(args[0], args[1], args[2]); !got.IsNaN() {
+			t.Errorf("FMA(%v, %v, %v) = %v, want NaN", args[0], args[1], args[2], got)
+		}
+	}
+	if got := FMA(One(), NegativeNaN, NaN); got != NegativeNaN {
+		t.Errorf("FMA(1, -NaN, NaN) = %v, want the first NaN argument", got)
+	}
+}
+
+func TestClamp01(t *testing.T) {
+	tests := []struct {
+		name string
+		f    Float8
+		want Float8
+	}{
+		{"below zero", FromInt(-3), PositiveZero},
+		{"negative infinity", NegativeInfinity, PositiveZero},
+		{"inside", ToFloat8(0.375), ToFloat8(0.375)},
+		{"one", One(), One()},
+		{"above one", ToFloat8(1.125), One()},
+		{"positive infinity", PositiveInfinity, One()},
+		{"negative zero", NegativeZero, NegativeZero},
+		{"NaN", NaN, NaN},
+		{"negative NaN", NegativeNaN, NegativeNaN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Clamp01(tt.f); got != tt.want {
+				t.Errorf("Clamp01(%v) = %v, want %v", tt.f, got, tt.want)
+			}
+			if got := Saturate(tt.f); got != tt.want {
+				t.Errorf("Saturate(%v) = %v, want %v", tt.f, got, tt.want)
+			}
+			if got, want := Clamp01(tt.f), Clamp(tt.f, PositiveZero, One()); got != want {
+				t.Errorf("Clamp01(%v) = %v, want Clamp result %v", tt.f, got, want)
+			}
+		})
+	}
+
+	s := []Float8{FromInt(-1), ToFloat8(0.5), FromInt(2), NaN}
+	if got, want := Clamp01Slice(s), []Float8{PositiveZero, ToFloat8(0.5), One(), NaN}; !equalBits(got, want) {
+		t.Errorf("Clamp01Slice(%v) = %v, want %v", s, got, want)
+	}
+}
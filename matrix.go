@@ -0,0 +1,153 @@
+package float8
+
+import (
+	"fmt"
+)
+
+// Mat8 is a dense row-major matrix of Float8 values.
+//
+// Element (i, j) is stored at Data[i*Cols+j]. Matrix products accumulate
+// each dot product in float32 and round the result to Float8 once, since
+// accumulating in Float8 loses precision after only a few terms.
+type Mat8 struct {
+	Rows, Cols int
+	Data       []Float8
+}
+
+// NewMat8 returns a rows×cols matrix with every element set to PositiveZero.
+// It panics if rows or cols is negative.
+func NewMat8(rows, cols int) *Mat8 {
+	if rows < 0 || cols < 0 {
+		panic("float8: negative matrix dimension")
+	}
+	return &Mat8{Rows: rows, Cols: cols, Data: make([]Float8, rows*cols)}
+}
+
+// At returns element (i, j). It panics if the indices are out of range.
+func (m *Mat8) At(i, j int) Float8 {
+	return m.Data[m.index(i, j)]
+}
+
+// Set sets element (i, j) to v. It panics if the indices are out of range.
+func (m *Mat8) Set(i, j int, v Float8) {
+	m.Data[m.index(i, j)] = v
+}
+
+// index returns the offset of element (i, j) in m.Data.
+func (m *Mat8) index(i, j int) int {
+	if i < 0 || i >= m.Rows || j < 0 || j >= m.Cols {
+		panic(fmt.Sprintf("float8: matrix index (%d, %d) out of range for %d×%d matrix", i, j, m.Rows, m.Cols))
+	}
+	return i*m.Cols + j
+}
+
+// MatMul returns the matrix product a×b.
+//
+// Each element is accumulated in float32 and rounded to Float8 once. It
+// returns an error if a.Cols does not equal b.Rows.
+func MatMul(a, b *Mat8) (*Mat8, error) {
+	if a.Cols != b.Rows {
+		return nil, &Float8Error{
+			Op:  "matmul",
+			Msg: fmt.Sprintf("dimension mismatch: %d×%d times %d×%d", a.Rows, a.Cols, b.Rows, b.Cols),
+		}
+	}
+
+	result := NewMat8(a.Rows, b.Cols)
+	for i := 0; i < a.Rows; i++ {
+		row := a.Data[i*a.Cols : (i+1)*a.Cols]
+		for j := 0; j < b.Cols; j++ {
+			var sum float32
+			for k, v := range row {
+				sum += v.ToFloat32() * b.Data[k*b.Cols+j].ToFloat32()
+			}
+			result.Data[i*result.Cols+j] = roundFloat32(sum, FlushToZero)
+		}
+	}
+	return result, nil
+}
+
+// ToFloat32Matrix returns the elements of m as a slice of float32 rows.
+func (m *Mat8) ToFloat32Matrix() [][]float32 {
+	rows := make([][]float32, m.Rows)
+	for i := range rows {
+		rows[i] = ToSlice32(m.Data[i*m.Cols : (i+1)*m.Cols])
+		if rows[i] == nil {
+			rows[i] = []float32{}
+		}
+	}
+	return rows
+}
+
+// FromFloat32Matrix converts a slice of float32 rows to a Mat8 using ToFloat8.
+// It returns an error if the rows do not all have the same length.
+func FromFloat32Matrix(rows [][]float32) (*Mat8, error) {
+	cols := 0
+	if len(rows) > 0 {
+		cols = len(rows[0])
+	}
+
+	m := NewMat8(len(rows), cols)
+	for i, row := range rows {
+		if len(row) != cols {
+			return nil, &Float8Error{
+				Op:  "matrix",
+				Msg: fmt.Sprintf("row %d has length %d, want %d", i, len(row), cols),
+			}
+		}
+		ToSlice8Into(m.Data[i*cols:(i+1)*cols], row)
+	}
+	return m, nil
+}
+
+// Identity returns the n×n identity matrix, with One() on the diagonal and
+// PositiveZero elsewhere.
+func Identity(n int) *Mat8 {
+	m := NewMat8(n, n)
+	for i := 0; i < n; i++ {
+		m.Data[i*n+i] = One()
+	}
+	return m
+}
+
+// Transpose returns a new matrix that is the transpose of m.
+func (m *Mat8) Transpose() *Mat8 {
+	t := NewMat8(m.Cols, m.Rows)
+	for i := 0; i < m.Rows; i++ {
+		for j := 0; j < m.Cols; j++ {
+			t.Data[j*t.Cols+i] = m.Data[i*m.Cols+j]
+		}
+	}
+	return t
+}
+
+// Outer returns the len(a)×len(b) outer product of a and b, whose element
+// (i, j) is a[i]*b[j] computed in float32 and rounded to Float8.
+func Outer(a, b []Float8) *Mat8 {
+	m := NewMat8(len(a), len(b))
+	for i, x := range a {
+		for j, y := range b {
+			m.Data[i*m.Cols+j] = roundFloat32(x.ToFloat32()*y.ToFloat32(), FlushToZero)
+		}
+	}
+	return m
+}
+
+// MatVec returns the matrix-vector product m×v, treating v as a column vector.
+//
+// Each element is accumulated in float32 and rounded to Float8 once. It
+// returns an error if len(v) does not equal m.Cols.
+func MatVec(m *Mat8, v []Float8) ([]Float8, error) {
+	if len(v) != m.Cols {
+		return nil, &Float8Error{
+			Op:  "matvec",
+			Msg: fmt.Sprintf("dimension mismatch: %d×%d times vector of length %d", m.Rows, m.Cols, len(v)),
+		}
+	}
+
+	result := make([]Float8, m.Rows)
+	for i := range result {
+		result[i] = roundFloat32(dot(m.Data[i*m.Cols:(i+1)*m.Cols], v), FlushToZero)
+	}
+	return result, nil
+}
@@ -0,0 +1,211 @@
+package float8
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMat8AtSet(t *testing.T) {
+	m := NewMat8(2, 3)
+	if m.Rows != 2 || m.Cols != 3 || len(m.Data) != 6 {
+		t.Fatalf("NewMat8(2, 3) = %d×%d with %d elements", m.Rows, m.Cols, len(m.Data))
+	}
+	for _, v := range m.Data {
+		if v != PositiveZero {
+			t.Fatalf("NewMat8 element = %v, want 0", v)
+		}
+	}
+
+	m.Set(1, 2, FromInt(5))
+	if got := m.At(1, 2); got != FromInt(5) {
+		t.Errorf("At(1, 2) = %v, want 5", got)
+	}
+	if m.Data[5] != FromInt(5) {
+		t.Errorf("Data[5] = %v, want 5 (row-major layout)", m.Data[5])
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for out-of-range index, but got none")
+		}
+	}()
+	_ = m.At(2, 0)
+}
+
+func TestMatMul(t *testing.T) {
+	af := [][]float32{{1, 2, 3}, {-4, 0.5, 6}}
+	bf := [][]float32{{7, -8}, {9, 10}, {0.25, 12}}
+
+	a, err := FromFloat32Matrix(af)
+	if err != nil {
+		t.Fatalf("FromFloat32Matrix() error = %v", err)
+	}
+	b, err := FromFloat32Matrix(bf)
+	if err != nil {
+		t.Fatalf("FromFloat32Matrix() error = %v", err)
+	}
+
+	c, err := MatMul(a, b)
+	if err != nil {
+		t.Fatalf("MatMul() error = %v", err)
+	}
+	if c.Rows != 2 || c.Cols != 2 {
+		t.Fatalf("MatMul() is %d×%d, want 2×2", c.Rows, c.Cols)
+	}
+
+	// Reference product of the quantized inputs, computed in float32
+	got := c.ToFloat32Matrix()
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			var want float32
+			for k := 0; k < 3; k++ {
+				want += a.At(i, k).ToFloat32() * b.At(k, j).ToFloat32()
+			}
+			if got[i][j] != ToFloat8(want).ToFloat32() {
+				t.Errorf("MatMul()[%d][%d] = %g, want %g (reference %g)", i, j, got[i][j], ToFloat8(want).ToFloat32(), want)
+			}
+		}
+	}
+}
+
+func TestMatMulDimensionMismatch(t *testing.T) {
+	if _, err := MatMul(NewMat8(2, 3), NewMat8(2, 3)); err == nil {
+		t.Error("MatMul(2×3, 2×3) returned no error")
+	}
+}
+
+func TestFloat32MatrixConversion(t *testing.T) {
+	rows := [][]float32{{1, -2}, {0.5, 448}, {0, -0.25}}
+	m, err := FromFloat32Matrix(rows)
+	if err != nil {
+		t.Fatalf("FromFloat32Matrix() error = %v", err)
+	}
+	if m.Rows != 3 || m.Cols != 2 {
+		t.Fatalf("FromFloat32Matrix() is %d×%d, want 3×2", m.Rows, m.Cols)
+	}
+
+	back := m.ToFloat32Matrix()
+	for i := range rows {
+		for j := range rows[i] {
+			if back[i][j] != rows[i][j] {
+				t.Errorf("round trip [%d][%d] = %g, want %g", i, j, back[i][j], rows[i][j])
+			}
+		}
+	}
+
+	if _, err := FromFloat32Matrix([][]float32{{1, 2}, {3}}); err == nil {
+		t.Error("FromFloat32Matrix(ragged) returned no error")
+	}
+
+	empty, err := FromFloat32Matrix(nil)
+	if err != nil || empty.Rows != 0 || empty.Cols != 0 {
+		t.Errorf("FromFloat32Matrix(nil) = %v, %v, want an empty matrix", empty, err)
+	}
+}
+
+func TestIdentity(t *testing.T) {
+	m := Identity(3)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			want := PositiveZero
+			if i == j {
+				want = One()
+			}
+			if got := m.At(i, j); got != want {
+				t.Errorf("Identity(3).At(%d, %d) = %v, want %v", i, j, got, want)
+			}
+		}
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	m, _ := FromFloat32Matrix([][]float32{{1, 2, 3}, {-4, 0.5, 448}})
+
+	tr := m.Transpose()
+	if tr.Rows != 3 || tr.Cols != 2 {
+		t.Fatalf("Transpose() is %d×%d, want 3×2", tr.Rows, tr.Cols)
+	}
+	for i := 0; i < m.Rows; i++ {
+		for j := 0; j < m.Cols; j++ {
+			if tr.At(j, i) != m.At(i, j) {
+				t.Errorf("Transpose().At(%d, %d) = %v, want %v", j, i, tr.At(j, i), m.At(i, j))
+			}
+		}
+	}
+
+	back := tr.Transpose()
+	if back.Rows != m.Rows || back.Cols != m.Cols || !slices.Equal(back.Data, m.Data) {
+		t.Errorf("Transpose().Transpose() = %v, want %v", back, m)
+	}
+}
+
+func TestMatMulIdentity(t *testing.T) {
+	m, _ := FromFloat32Matrix([][]float32{{1, -2.5, 3}, {0.125, 448, -0.001953125}})
+
+	right, err := MatMul(m, Identity(3))
+	if err != nil {
+		t.Fatalf("MatMul(m, I) error = %v", err)
+	}
+	left, err := MatMul(Identity(2), m)
+	if err != nil {
+		t.Fatalf("MatMul(I, m) error = %v", err)
+	}
+	if !slices.Equal(right.Data, m.Data) || !slices.Equal(left.Data, m.Data) {
+		t.Errorf("identity product changed the matrix: m×I = %v, I×m = %v, want %v", right.Data, left.Data, m.Data)
+	}
+}
+
+func TestOuter(t *testing.T) {
+	e0 := []Float8{One(), PositiveZero, PositiveZero}
+	e1 := []Float8{PositiveZero, One()}
+
+	m := Outer(e0, e1)
+	if m.Rows != 3 || m.Cols != 2 {
+		t.Fatalf("Outer() is %d×%d, want 3×2", m.Rows, m.Cols)
+	}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 2; j++ {
+			want := PositiveZero
+			if i == 0 && j == 1 {
+				want = One()
+			}
+			if got := m.At(i, j); got != want {
+				t.Errorf("Outer(e0, e1).At(%d, %d) = %v, want %v", i, j, got, want)
+			}
+		}
+	}
+
+	a := []Float8{FromInt(2), FromInt(-3)}
+	b := []Float8{ToFloat8(0.5), FromInt(4), PositiveInfinity}
+	m = Outer(a, b)
+	expected := []Float8{One(), FromInt(8), PositiveInfinity, ToFloat8(-1.5), FromInt(-12), NegativeInfinity}
+	if !slices.Equal(m.Data, expected) {
+		t.Errorf("Outer(%v, %v) = %v, want %v", a, b, m.Data, expected)
+	}
+}
+
+func TestMatVec(t *testing.T) {
+	m, _ := FromFloat32Matrix([][]float32{{1, 2, 3}, {-4, 0.5, 6}, {100, 100, 100}})
+	v := []Float8{FromInt(7), FromInt(-2), ToFloat8(0.25)}
+
+	got, err := MatVec(m, v)
+	if err != nil {
+		t.Fatalf("MatVec() error = %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("len(MatVec()) = %d, want 3", len(got))
+	}
+	for i := range got {
+		var want float32
+		for j := range v {
+			want += m.At(i, j).ToFloat32() * v[j].ToFloat32()
+		}
+		if got[i] != ToFloat8(want) {
+			t.Errorf("MatVec()[%d] = %v, want %v (reference %g)", i, got[i], ToFloat8(want), want)
+		}
+	}
+
+	if _, err := MatVec(m, v[:2]); err == nil {
+		t.Error("MatVec with a short vector returned no error")
+	}
+}
@@ -0,0 +1,152 @@
+package float8
+
+import (
+	"math"
+)
+
+// Vector norms
+//
+// Norms accumulate in float32 and round the result to Float8 once, so the
+// intermediate sums may exceed the Float8 range without overflowing. Only a
+// norm that is itself larger than MaxValue rounds to PositiveInfinity.
+
+// NormL1 returns the L1 norm of s, the sum of the absolute values of its
+// elements.
+//
+// Special cases are:
+//
+//	NormL1([]) = +0
+//	NormL1(s) = +Inf if any element is infinite
+//	NormL1(s) = NaN if any element is NaN
+func NormL1(s []Float8) Float8 {
+	var sum float32
+	for _, v := range s {
+		sum += float32(math.Abs(float64(v.ToFloat32())))
+	}
+	return roundFloat32(sum, FlushToZero)
+}
+
+// NormL2 returns the L2 (Euclidean) norm of s, the square root of the sum
+// of the squares of its elements.
+//
+// Special cases are the same as for NormL1.
+func NormL2(s []Float8) Float8 {
+	return roundFloat32(normL2(s), FlushToZero)
+}
+
+// normL2 returns the float32 Euclidean norm of s.
+func normL2(s []Float8) float32 {
+	return float32(math.Sqrt(float64(SumOfSquaresFloat32(s))))
+}
+
+// SumOfSquares returns the sum of the squares of the elements of s,
+// accumulated in float32 and rounded once, so a sum larger than MaxValue
+// rounds to PositiveInfinity even if every element is small.
+//
+// Special cases are the same as for NormL1.
+func SumOfSquares(s []Float8) Float8 {
+	return roundFloat32(SumOfSquaresFloat32(s), FlushToZero)
+}
+
+// SumOfSquaresFloat32 returns the sum of the squares of the elements of s as
+// an unrounded float32, for callers that continue in higher precision, for
+// example to compute a root mean square. It returns 0 for an empty s.
+func SumOfSquaresFloat32(s []Float8) float32 {
+	var sum float32
+	for _, v := range s {
+		x := v.ToFloat32()
+		sum += x * x
+	}
+	return sum
+}
+
+// NormLInf returns the L-infinity norm of s, the largest absolute value of
+// its elements.
+//
+// Special cases are the same as for NormL1.
+func NormLInf(s []Float8) Float8 {
+	result := PositiveZero
+	for _, v := range s {
+		if v.IsNaN() {
+			return NaN
+		}
+		result = Max(result, v.Abs())
+	}
+	return result
+}
+
+// ClipByNorm returns a copy of s rescaled so that its L2 norm is at most
+// maxNorm, as used for gradient clipping by global norm. If the float32 norm
+// of s exceeds maxNorm, every element is multiplied by maxNorm/norm in
+// float32 and rounded once; otherwise the elements are copied unchanged.
+// Because each element is rounded independently, the norm of the result
+// matches maxNorm only to within Float8 precision.
+//
+// It panics if maxNorm is negative.
+//
+// Special cases are:
+//
+//	ClipByNorm(nil, maxNorm) = nil
+//	ClipByNorm(s, maxNorm) = copy of s if maxNorm is NaN or +Inf
+//	ClipByNorm(s, maxNorm) = copy of s if any element is NaN or infinite
+func ClipByNorm(s []Float8, maxNorm Float8) []Float8 {
+	if maxNorm.Sign() < 0 {
+		panic("float8: negative max norm")
+	}
+	if s == nil {
+		return nil
+	}
+
+	result := make([]Float8, len(s))
+	copy(result, s)
+
+	norm, limit := normL2(s), maxNorm.ToFloat32()
+	if !(norm > limit) || math.IsInf(float64(norm), 0) {
+		return result
+	}
+	factor := limit / norm
+	for i, v := range s {
+		result[i] = roundFloat32(v.ToFloat32()*factor, FlushToZero)
+	}
+	return result
+}
+
+// ClipByValue returns a copy of s with each element clamped to [min, max].
+// It is equivalent to ClampSlice and is provided alongside ClipByNorm for
+// gradient clipping.
+func ClipByValue(s []Float8, min, max Float8) []Float8 {
+	return ClampSlice(s, min, max)
+}
+
+// CosineSimilarity returns the cosine of the angle between a and b,
+// dot(a, b) / (‖a‖·‖b‖), computed in float32 and rounded to Float8 once.
+//
+// It panics if a and b have different lengths.
+//
+// Special cases are:
+//
+//	CosineSimilarity(a, b) = +0 if either norm is zero (including empty slices)
+//	CosineSimilarity(a, b) = NaN if any element is NaN or infinite
+func CosineSimilarity(a, b []Float8) Float8 {
+	if len(a) != len(b) {
+		panic("float8: slice length mismatch")
+	}
+
+	normA, normB := normL2(a), normL2(b)
+	if normA == 0 || normB == 0 {
+		return PositiveZero
+	}
+	if math.IsInf(float64(normA), 0) || math.IsInf(float64(normB), 0) {
+		return NaN
+	}
+	return roundFloat32(dot(a, b)/(normA*normB), FlushToZero)
+}
+
+// dot returns the float32 dot product of a and b, which must have the same length.
+func dot(a, b []Float8) float32 {
+	var sum float32
+	for i := range a {
+		sum += a[i].ToFloat32() * b[i].ToFloat32()
+	}
+	return sum
+}
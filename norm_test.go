@@ -0,0 +1,260 @@
+package float8
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNorms(t *testing.T) {
+	tests := []struct {
+		name string
+		s    []Float8
+	}{
+		{"empty", nil},
+		{"single", []Float8{FromInt(-3)}},
+		{"3-4-5", []Float8{FromInt(3), FromInt(-4)}},
+		{"mixed magnitudes", []Float8{ToFloat8(0.125), FromInt(-20), ToFloat8(1.5), FromInt(7), ToFloat8(-0.03125)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var l1, l2, linf float64
+			for _, v := range tt.s {
+				x := math.Abs(float64(v.ToFloat32()))
+				l1 += x
+				l2 += x * x
+				linf = math.Max(linf, x)
+			}
+			l2 = math.Sqrt(l2)
+
+			for _, c := range []struct {
+				name string
+				got  Float8
+				want float64
+			}{
+				{"NormL1", NormL1(tt.s), l1},
+				{"NormL2", NormL2(tt.s), l2},
+				{"NormLInf", NormLInf(tt.s), linf},
+			} {
+				if want := ToFloat8(float32(c.want)); c.got != want {
+					t.Errorf("%s(%v) = %v, want %v (reference %g)", c.name, tt.s, c.got, want, c.want)
+				}
+			}
+		})
+	}
+
+	t.Run("squares exceed MaxValue", func(t *testing.T) {
+		// 100² × 4 overflows Float8 but the norm itself is only 200
+		s := []Float8{FromInt(100), FromInt(-100), FromInt(100), FromInt(100)}
+		if got := NormL2(s); got != FromInt(200) {
+			t.Errorf("NormL2(%v) = %v, want 200", s, got)
+		}
+	})
+
+	t.Run("norm overflows", func(t *testing.T) {
+		s := []Float8{MaxValue, MaxValue}
+		if got := NormL1(s); got != PositiveInfinity {
+			t.Errorf("NormL1(%v) = %v, want +Inf", s, got)
+		}
+		if got := NormL2(s); got != PositiveInfinity {
+			t.Errorf("NormL2(%v) = %v, want +Inf", s, got)
+		}
+	})
+}
+
+func TestNormSpecialCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        []Float8
+		expected Float8
+	}{
+		{"infinity", []Float8{One(), NegativeInfinity}, PositiveInfinity},
+		{"NaN", []Float8{One(), NaN, FromInt(2)}, NaN},
+		{"signed zeros", []Float8{NegativeZero, NegativeZero}, PositiveZero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, fn := range []struct {
+				name string
+				f    func([]Float8) Float8
+			}{{"NormL1", NormL1}, {"NormL2", NormL2}, {"NormLInf", NormLInf}} {
+				result := fn.f(tt.s)
+				if tt.expected.IsNaN() {
+					if !result.IsNaN() {
+						t.Errorf("%s(%v) = %v, want NaN", fn.name, tt.s, result)
+					}
+				} else if result != tt.expected {
+					t.Errorf("%s(%v) = 0x%02x, want 0x%02x", fn.name, tt.s, uint8(result), uint8(tt.expected))
+				}
+			}
+		})
+	}
+}
+
+func TestCosineSimilarity(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     []Float8
+		expected float32
+	}{
+		{"identical", []Float8{One(), FromInt(2), FromInt(3)}, []Float8{One(), FromInt(2), FromInt(3)}, 1},
+		{"parallel", []Float8{One(), FromInt(2)}, []Float8{FromInt(4), FromInt(8)}, 1},
+		{"orthogonal", []Float8{One(), PositiveZero}, []Float8{PositiveZero, FromInt(5)}, 0},
+		{"orthogonal mixed", []Float8{One(), One()}, []Float8{One(), FromInt(-1)}, 0},
+		{"anti-parallel", []Float8{One(), FromInt(-2), FromInt(3)}, []Float8{FromInt(-1), FromInt(2), FromInt(-3)}, -1},
+		{"large values", []Float8{FromInt(300), FromInt(400)}, []Float8{FromInt(300), FromInt(400)}, 1},
+		{"zero vector", []Float8{PositiveZero, PositiveZero}, []Float8{One(), One()}, 0},
+		{"empty", nil, nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CosineSimilarity(tt.a, tt.b)
+			if !AlmostEqual(result, ToFloat8(tt.expected), ToFloat8(0.0625)) {
+				t.Errorf("CosineSimilarity(%v, %v) = %v, want ≈%g", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("NaN", func(t *testing.T) {
+		if got := CosineSimilarity([]Float8{NaN, One()}, []Float8{One(), One()}); !got.IsNaN() {
+			t.Errorf("CosineSimilarity with NaN = %v, want NaN", got)
+		}
+		if got := CosineSimilarity([]Float8{PositiveInfinity, One()}, []Float8{One(), One()}); !got.IsNaN() {
+			t.Errorf("CosineSimilarity with +Inf = %v, want NaN", got)
+		}
+	})
+
+	t.Run("panic on length mismatch", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected panic for slice length mismatch, but got none")
+			}
+		}()
+		_ = CosineSimilarity([]Float8{One(), One()}, []Float8{One()})
+		t.Error("Expected panic but function completed successfully")
+	})
+}
+
+func TestClipByNorm(t *testing.T) {
+	t.Run("under threshold unchanged", func(t *testing.T) {
+		s := []Float8{FromInt(3), FromInt(-4)}
+		got := ClipByNorm(s, FromInt(5))
+		if !equalBits(got, s) {
+			t.Errorf("ClipByNorm(%v, 5) = %v, want unchanged", s, got)
+		}
+		got[0] = PositiveZero
+		if s[0] != FromInt(3) {
+			t.Error("ClipByNorm result aliases its input")
+		}
+	})
+
+	t.Run("over threshold scaled to max norm", func(t *testing.T) {
+		s := []Float8{FromInt(30), FromInt(-40)}
+		got := ClipByNorm(s, FromInt(5))
+		want := []Float8{FromInt(3), FromInt(-4)}
+		if !equalBits(got, want) {
+			t.Errorf("ClipByNorm(%v, 5) = %v, want %v", s, got, want)
+		}
+	})
+
+	t.Run("norm within rounding", func(t *testing.T) {
+		s := []Float8{ToFloat8(1.5), FromInt(-7), FromInt(20), ToFloat8(0.125), FromInt(11)}
+		for _, m := range []Float8{One(), FromInt(2), FromInt(10)} {
+			got := NormL2(ClipByNorm(s, m)).ToFloat32()
+			if want := m.ToFloat32(); math.Abs(float64(got-want)) > float64(want)/8 {
+				t.Errorf("NormL2(ClipByNorm(s, %v)) = %v, want ≈ %v", m, got, want)
+			}
+		}
+	})
+
+	t.Run("special cases", func(t *testing.T) {
+		s := []Float8{FromInt(3), FromInt(-4)}
+		if got := ClipByNorm(nil, One()); got != nil {
+			t.Errorf("ClipByNorm(nil, 1) = %v, want nil", got)
+		}
+		if got := ClipByNorm(s, PositiveZero); !equalBits(got, []Float8{PositiveZero, NegativeZero}) {
+			t.Errorf("ClipByNorm(%v, 0) = %v, want [+0 -0]", s, got)
+		}
+		for _, m := range []Float8{NaN, PositiveInfinity} {
+			if got := ClipByNorm(s, m); !equalBits(got, s) {
+				t.Errorf("ClipByNorm(%v, %v) = %v, want unchanged", s, m, got)
+			}
+		}
+		for _, bad := range [][]Float8{{One(), NaN}, {One(), NegativeInfinity}} {
+			if got := ClipByNorm(bad, One()); !equalBits(got, bad) {
+				t.Errorf("ClipByNorm(%v, 1) = %v, want unchanged", bad, got)
+			}
+		}
+	})
+
+	t.Run("negative max norm panics", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected panic but function completed successfully")
+			}
+		}()
+		ClipByNorm([]Float8{One()}, FromInt(-1))
+	})
+}
+
+func TestClipByValue(t *testing.T) {
+	s := []Float8{FromInt(-8), ToFloat8(0.5), FromInt(6), NaN}
+	got := ClipByValue(s, FromInt(-1), One())
+	want := []Float8{FromInt(-1), ToFloat8(0.5), One(), NaN}
+	if !equalBits(got, want) {
+		t.Errorf("ClipByValue(%v, -1, 1) = %v, want %v", s, got, want)
+	}
+}
+
+func TestSumOfSquares(t *testing.T) {
+	tests := []struct {
+		name string
+		s    []Float8
+	}{
+		{"empty", nil},
+		{"single", []Float8{FromInt(-3)}},
+		{"mixed", []Float8{ToFloat8(0.125), FromInt(-5), ToFloat8(1.5), FromInt(7), ToFloat8(-0.03125)}},
+		{"subnormals", []Float8{SmallestPositive, SmallestPositive + 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var want float32
+			for _, v := range tt.s {
+				want += v.ToFloat32() * v.ToFloat32()
+			}
+			if got := SumOfSquaresFloat32(tt.s); got != want {
+				t.Errorf("SumOfSquaresFloat32(%v) = %v, want %v", tt.s, got, want)
+			}
+			if got := SumOfSquares(tt.s); got != ToFloat8(want) {
+				t.Errorf("SumOfSquares(%v) = %v, want %v", tt.s, got, ToFloat8(want))
+			}
+		})
+	}
+
+	if got := SumOfSquares(nil); got != PositiveZero {
+		t.Errorf("SumOfSquares(nil) = %v, want +0", got)
+	}
+
+	t.Run("overflow", func(t *testing.T) {
+		// Every square is 144 but the sum 576 exceeds the Float8 range
+		s := []Float8{FromInt(12), FromInt(-12), FromInt(12), FromInt(12)}
+		if got := SumOfSquares(s); got != PositiveInfinity {
+			t.Errorf("SumOfSquares(%v) = %v, want +Inf", s, got)
+		}
+		if got := SumOfSquaresFloat32(s); got != 576 {
+			t.Errorf("SumOfSquaresFloat32(%v) = %v, want 576", s, got)
+		}
+	})
+
+	t.Run("special values", func(t *testing.T) {
+		if got := SumOfSquares([]Float8{One(), NegativeInfinity}); got != PositiveInfinity {
+			t.Errorf("SumOfSquares([1 -Inf]) = %v, want +Inf", got)
+		}
+		if got := SumOfSquares([]Float8{One(), NaN}); !got.IsNaN() {
+			t.Errorf("SumOfSquares([1 NaN]) = %v, want NaN", got)
+		}
+	})
+}
@@ -0,0 +1,399 @@
+package float8
+
+import (
+	"math"
+)
+
+// Scaled quantization helpers
+//
+// FP8 tensors are usually stored alongside a float32 scale factor so that the
+// tensor's dynamic range fits the format: values are quantized as x/scale and
+// dequantized as q*scale.
+
+// Amax returns the largest absolute value in s, the basis of amax scaling.
+//
+// NaN elements are ignored, so a stray NaN does not poison the scale; use
+// NormLInf to propagate NaN instead. An infinite element of either sign makes
+// the result +Inf. Amax returns PositiveZero if s is empty or all NaN.
+func Amax(s []Float8) Float8 {
+	result := PositiveZero
+	for _, v := range s {
+		if !v.IsNaN() {
+			result = Max(result, v.Abs())
+		}
+	}
+	return result
+}
+
+// AmaxFloat32 returns the largest absolute value in x, for computing scales
+// before quantization. It follows the same rules as Amax: NaN elements are
+// ignored, an infinite element gives +Inf, and an empty or all-NaN x gives 0.
+func AmaxFloat32(x []float32) float32 {
+	var result float32
+	for _, v := range x {
+		if !math.IsNaN(float64(v)) {
+			result = max(result, float32(math.Abs(float64(v))))
+		}
+	}
+	return result
+}
+
+// ComputeScale returns the amax-based scale factor for x.
+//
+// The scale maps the largest finite absolute value in x onto MaxValue (448), so
+// that Quantize(x, ComputeScale(x)) uses the full range of the format. NaN and
+// infinite elements are ignored. If x is empty or contains no nonzero finite
+// values, ComputeScale returns 1. A scale that would fall below the smallest
+// normal float32 (2^-126), as it does when the peak is a float32 subnormal, is
+// raised to it, so Quantize never divides by a zero scale.
+func ComputeScale(x []float32) float32 {
+	var amax float32
+	for _, v := range x {
+		if math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+			continue
+		}
+		amax = max(amax, float32(math.Abs(float64(v))))
+	}
+	if amax == 0 {
+		return 1
+	}
+	return max(amax/MaxValue.ToFloat32(), smallestNormalFloat32)
+}
+
+// smallestNormalFloat32 is 2^-126, the smallest positive normal float32
+var smallestNormalFloat32 = math.Float32frombits(0x00800000)
+
+// Quantize converts x to Float8 after dividing each element by scale.
+//
+// The scale should be positive and finite, typically obtained from ComputeScale.
+// Elements whose scaled value falls outside the Float8 range saturate the same
+// way as ToFloat8.
+//
+// Returns nil if x is nil.
+func Quantize(x []float32, scale float32) []Float8 {
+	if x == nil {
+		return nil
+	}
+
+	result := make([]Float8, len(x))
+	for i, v := range x {
+		result[i] = ToFloat8(v / scale)
+	}
+	return result
+}
+
+// QuantizeDithered converts x to Float8 after adding the caller-supplied
+// dither signal, element by element: result[i] = ToFloat8(x[i] + dither[i]).
+//
+// Adding dither before rounding decorrelates the quantization error from the
+// signal. Unlike stochastic rounding, the dither is explicit, so the result is
+// reproducible for a given dither signal. A zero dither element leaves x[i]
+// unchanged, including its sign if it is -0, so an all-zero dither gives the
+// same result as ToSlice8.
+//
+// It returns an error if x and dither have different lengths, and nil if x is
+// nil.
+func QuantizeDithered(x []float32, dither []float32) ([]Float8, error) {
+	if len(x) != len(dither) {
+		return nil, &Float8Error{Op: "quantize", Msg: "dither length does not match input length"}
+	}
+	if x == nil {
+		return nil, nil
+	}
+
+	result := make([]Float8, len(x))
+	for i, v := range x {
+		if dither[i] != 0 {
+			v += dither[i]
+		}
+		result[i] = ToFloat8(v)
+	}
+	return result, nil
+}
+
+// Dequantize converts q to float32 and multiplies each element by scale.
+//
+// It is the inverse of Quantize up to the quantization error of the format.
+//
+// Returns nil if q is nil.
+func Dequantize(q []Float8, scale float32) []float32 {
+	if q == nil {
+		return nil
+	}
+
+	result := make([]float32, len(q))
+	for i, v := range q {
+		result[i] = v.ToFloat32() * scale
+	}
+	return result
+}
+
+// QuantizePerChannel quantizes the rows of x with a separate amax scale for
+// each channel along axis, as used for FP8 weight matrices.
+//
+// With axis 0 each row is a channel: scales[i] = ComputeScale(x[i]), and rows
+// may have different lengths. With axis 1 each column is a channel:
+// scales[j] is computed from x[0][j], x[1][j], ..., and every row must have
+// the same length. Each element is quantized as Quantize does, by dividing by
+// its channel's scale, so every channel's peak magnitude maps onto MaxValue.
+//
+// Panics if axis is not 0 or 1, or if axis is 1 and the rows of x have
+// different lengths.
+func QuantizePerChannel(x [][]float32, axis int) (q [][]Float8, scales []float32) {
+	switch axis {
+	case 0:
+		q = make([][]Float8, len(x))
+		scales = make([]float32, len(x))
+		for i, row := range x {
+			scales[i] = ComputeScale(row)
+			q[i] = Quantize(row, scales[i])
+		}
+		return q, scales
+	case 1:
+		cols := checkChannelRows(len(x), func(i int) int { return len(x[i]) })
+		column := make([]float32, len(x))
+		scales = make([]float32, cols)
+		for j := range scales {
+			for i, row := range x {
+				column[i] = row[j]
+			}
+			scales[j] = ComputeScale(column)
+		}
+		q = make([][]Float8, len(x))
+		for i, row := range x {
+			q[i] = make([]Float8, cols)
+			for j, v := range row {
+				q[i][j] = ToFloat8(v / scales[j])
+			}
+		}
+		return q, scales
+	}
+	panic("float8: channel axis must be 0 or 1")
+}
+
+// DequantizePerChannel inverts QuantizePerChannel, multiplying each element
+// of q by the scale of its channel along axis.
+//
+// Panics if axis is not 0 or 1, if len(scales) does not match the number of
+// channels, or if axis is 1 and the rows of q have different lengths.
+func DequantizePerChannel(q [][]Float8, scales []float32, axis int) [][]float32 {
+	x := make([][]float32, len(q))
+	switch axis {
+	case 0:
+		if len(scales) != len(q) {
+			panic("float8: slice length mismatch")
+		}
+		for i, row := range q {
+			x[i] = Dequantize(row, scales[i])
+		}
+		return x
+	case 1:
+		cols := checkChannelRows(len(q), func(i int) int { return len(q[i]) })
+		if len(scales) != cols {
+			panic("float8: slice length mismatch")
+		}
+		for i, row := range q {
+			x[i] = make([]float32, cols)
+			for j, v := range row {
+				x[i][j] = v.ToFloat32() * scales[j]
+			}
+		}
+		return x
+	}
+	panic("float8: channel axis must be 0 or 1")
+}
+
+// QuantizeBlocks quantizes x with a separate amax scale for each block of
+// blockSize consecutive elements, in the style of MX block-scaled formats.
+// If len(x) is not a multiple of blockSize, the last block is shorter and is
+// scaled by its own peak. There are (len(x)+blockSize-1)/blockSize scales.
+//
+// Panics if blockSize is less than 1.
+func QuantizeBlocks(x []float32, blockSize int) (q []Float8, scales []float32) {
+	if blockSize < 1 {
+		panic("float8: block size must be positive")
+	}
+	q = make([]Float8, len(x))
+	scales = make([]float32, (len(x)+blockSize-1)/blockSize)
+	for b := range scales {
+		start := b * blockSize
+		end := min(start+blockSize, len(x))
+		scales[b] = ComputeScale(x[start:end])
+		for i := start; i < end; i++ {
+			q[i] = ToFloat8(x[i] / scales[b])
+		}
+	}
+	return q, scales
+}
+
+// DequantizeBlocks inverts QuantizeBlocks, multiplying each element of q by
+// the scale of its block.
+//
+// Panics if blockSize is less than 1 or len(scales) does not match the
+// number of blocks in q.
+func DequantizeBlocks(q []Float8, scales []float32, blockSize int) []float32 {
+	if blockSize < 1 {
+		panic("float8: block size must be positive")
+	}
+	if len(scales) != (len(q)+blockSize-1)/blockSize {
+		panic("float8: slice length mismatch")
+	}
+	x := make([]float32, len(q))
+	for i, v := range q {
+		x[i] = v.ToFloat32() * scales[i/blockSize]
+	}
+	return x
+}
+
+// checkChannelRows returns the common length of n rows whose lengths are
+// given by rowLen, or 0 if n is 0. Panics if the lengths differ.
+func checkChannelRows(n int, rowLen func(i int) int) int {
+	if n == 0 {
+		return 0
+	}
+	cols := rowLen(0)
+	for i := 1; i < n; i++ {
+		if rowLen(i) != cols {
+			panic("float8: ragged rows")
+		}
+	}
+	return cols
+}
+
+// QuantizationSNR returns the signal-to-noise ratio, in dB, of converting x
+// to Float8 and back.
+//
+// Each element is converted with ToSlice8 and ToSlice32 without scaling, and
+// the result is 10*log10(signalPower/noisePower), where noisePower is the mean
+// squared round-trip error. NaN and infinite elements of x are ignored.
+//
+// Special cases are:
+//
+//	QuantizationSNR(x) = +Inf if the round trip is exact (including empty or all-zero x)
+//	QuantizationSNR(x) = -Inf if a finite element overflows to infinity
+func QuantizationSNR(x []float32) float64 {
+	restored := ToSlice32(ToSlice8(x))
+
+	var signal, noise float64
+	for i, v := range x {
+		if math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+			continue
+		}
+		diff := float64(v) - float64(restored[i])
+		signal += float64(v) * float64(v)
+		noise += diff * diff
+	}
+	if noise == 0 {
+		return math.Inf(1)
+	}
+	return 10 * math.Log10(signal/noise)
+}
+
+// Fidelity summarizes how well Float8 represents a float32 dataset; see
+// FidelityReport.
+type Fidelity struct {
+	MaxAbsError  float32 // Largest absolute round-trip error
+	MeanAbsError float32 // Mean absolute round-trip error
+	RMSError     float32 // Root mean square round-trip error
+
+	OverflowCount  int // Finite elements that converted to ±Inf
+	UnderflowCount int // Nonzero elements that converted to ±0
+}
+
+// FidelityReport converts x to Float8 and back with ToSlice8 and ToSlice32,
+// without scaling, and reports the round-trip error statistics.
+//
+// NaN and infinite elements of x are ignored. Elements that overflow are
+// counted in OverflowCount but left out of the error statistics, whose
+// infinite errors would otherwise mask everything else, so the statistics
+// describe the elements that stayed finite. Elements that underflow to zero
+// are both counted in UnderflowCount and included in the statistics. The
+// statistics are accumulated in float64, and are all zero if no element
+// contributes.
+func FidelityReport(x []float32) Fidelity {
+	restored := ToSlice32(ToSlice8(x))
+
+	var report Fidelity
+	var n int
+	var sumAbs, sumSq float64
+	for i, v := range x {
+		if math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+			continue
+		}
+		r := restored[i]
+		if math.IsInf(float64(r), 0) {
+			report.OverflowCount++
+			continue
+		}
+		if r == 0 && v != 0 {
+			report.UnderflowCount++
+		}
+
+		diff := math.Abs(float64(v) - float64(r))
+		report.MaxAbsError = max(report.MaxAbsError, float32(diff))
+		sumAbs += diff
+		sumSq += diff * diff
+		n++
+	}
+	if n > 0 {
+		report.MeanAbsError = float32(sumAbs / float64(n))
+		report.RMSError = float32(math.Sqrt(sumSq / float64(n)))
+	}
+	return report
+}
+
+// MuLawCompress applies μ-law companding to f:
+//
+//	sgn(f) · ln(1 + μ|f|) / ln(1 + μ)
+//
+// The input is expected in [-1, 1], which maps onto [-1, 1] with small
+// magnitudes expanded so they use more of the Float8 encodings. Inputs outside
+// that range are not clamped and map beyond ±1. The transform is computed in
+// float32, like the functions in math.go: each logarithm is evaluated by the
+// math package and rounded to float32, and the quotient is rounded to Float8.
+//
+// mu must be positive and finite; otherwise the result is NaN. The telephony
+// standard μ = 255 is not representable and rounds to 240 as a Float8, which
+// changes the curve only slightly.
+func MuLawCompress(f Float8, mu Float8) Float8 {
+	if !validMu(mu) || f.IsNaN() {
+		return NaN
+	}
+	if f.IsZero() {
+		return f
+	}
+	x, m := f.Abs().ToFloat32(), mu.ToFloat32()
+	num := float32(math.Log1p(float64(m * x)))
+	den := float32(math.Log1p(float64(m)))
+	return CopySign(ToFloat8(num/den), f)
+}
+
+// MuLawExpand inverts MuLawCompress:
+//
+//	sgn(f) · ((1 + μ)^|f| - 1) / μ
+//
+// It has the same input range and rules for mu as MuLawCompress, and is
+// likewise computed in float32.
+//
+// Because the compressed value is itself rounded to Float8, a round trip
+// through MuLawCompress and MuLawExpand is only approximately the identity:
+// the expanded value is within one Float8 step of the original in the
+// compressed domain, which for μ = 240 is a relative error of up to about
+// 17% in [-1, 1].
+func MuLawExpand(f Float8, mu Float8) Float8 {
+	if !validMu(mu) || f.IsNaN() {
+		return NaN
+	}
+	if f.IsZero() {
+		return f
+	}
+	y, m := f.Abs().ToFloat32(), mu.ToFloat32()
+	scale := float32(math.Log1p(float64(m)))
+	num := float32(math.Expm1(float64(y * scale)))
+	return CopySign(ToFloat8(num/m), f)
+}
+
+// validMu reports whether mu is a usable μ-law parameter.
+func validMu(mu Float8) bool {
+	return mu.IsFinite() && mu.Sign() > 0
+}
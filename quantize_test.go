@@ -0,0 +1,468 @@
+package float8
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAmax(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        []Float8
+		expected Float8
+	}{
+		{"empty", nil, PositiveZero},
+		{"ignores sign", []Float8{One(), FromInt(-6), FromInt(3)}, FromInt(6)},
+		{"negative zero", []Float8{NegativeZero}, PositiveZero},
+		{"skips NaN", []Float8{NaN, FromInt(-2), NegativeNaN}, FromInt(2)},
+		{"all NaN", []Float8{NaN}, PositiveZero},
+		{"negative infinity", []Float8{One(), NegativeInfinity}, PositiveInfinity},
+		{"min value", []Float8{MinValue, MaxValue}, MaxValue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Amax(tt.s); got != tt.expected {
+				t.Errorf("Amax(%v) = %v, want %v", tt.s, got, tt.expected)
+			}
+			if got, want := AmaxFloat32(ToSlice32(tt.s)), tt.expected.ToFloat32(); got != want {
+				t.Errorf("AmaxFloat32(%v) = %v, want %v", ToSlice32(tt.s), got, want)
+			}
+		})
+	}
+
+	if got := AmaxFloat32([]float32{0.3, -1e30, 2}); got != 1e30 {
+		t.Errorf("AmaxFloat32([0.3 -1e30 2]) = %v, want 1e30", got)
+	}
+}
+
+func TestComputeScale(t *testing.T) {
+	tests := []struct {
+		name string
+		x    []float32
+		want float32
+	}{
+		{"peak at max value", []float32{1, -448, 3}, 1},
+		{"negative peak", []float32{0.5, -0.25, -1.75}, 1.75 / 448},
+		{"ignores non-finite", []float32{float32(math.NaN()), float32(math.Inf(1)), 2}, 2.0 / 448},
+		{"all zeros", []float32{0, 0}, 1},
+		{"empty", nil, 1},
+		{"subnormal peak", []float32{math.SmallestNonzeroFloat32}, math.Float32frombits(0x00800000)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ComputeScale(tt.x); got != tt.want {
+				t.Errorf("ComputeScale(%v) = %g, want %g", tt.x, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuantizeTinyScale(t *testing.T) {
+	x := []float32{math.SmallestNonzeroFloat32, -math.SmallestNonzeroFloat32, 0}
+	for i, q := range Quantize(x, ComputeScale(x)) {
+		if !q.IsFinite() {
+			t.Errorf("Quantize(%v)[%d] = %v, want a finite value", x, i, q)
+		}
+	}
+}
+
+func TestQuantizeRoundTrip(t *testing.T) {
+	x := make([]float32, 64)
+	for i := range x {
+		x[i] = float32(math.Sin(float64(i)*0.3)) * 0.01
+	}
+
+	scale := ComputeScale(x)
+	q := Quantize(x, scale)
+
+	// The peak magnitude maps onto MaxValue
+	var peak Float8
+	for _, v := range q {
+		if Greater(v.Abs(), peak) {
+			peak = v.Abs()
+		}
+	}
+	if peak != MaxValue {
+		t.Errorf("peak quantized magnitude = %v, want %v", peak, MaxValue)
+	}
+
+	// 3 mantissa bits give a relative rounding error of at most 2^-4, widened to
+	// about 9% around 256 where the reserved infinity encoding leaves a gap
+	got := Dequantize(q, scale)
+	for i := range x {
+		tol := math.Abs(float64(x[i]))/10 + float64(scale)/512
+		if diff := math.Abs(float64(got[i] - x[i])); diff > tol {
+			t.Errorf("Dequantize(Quantize(x))[%d] = %g, want %g (±%g)", i, got[i], x[i], tol)
+		}
+	}
+}
+
+func TestQuantizeNil(t *testing.T) {
+	if got := Quantize(nil, 1); got != nil {
+		t.Errorf("Quantize(nil) = %v, want nil", got)
+	}
+	if got := Dequantize(nil, 1); got != nil {
+		t.Errorf("Dequantize(nil) = %v, want nil", got)
+	}
+}
+
+func TestQuantizationSNR(t *testing.T) {
+	t.Run("sinusoid", func(t *testing.T) {
+		x := make([]float32, 1000)
+		for i := range x {
+			x[i] = float32(100 * math.Sin(float64(i)*0.05))
+		}
+		// 3 mantissa bits give roughly 6 dB per bit plus a few dB of headroom
+		snr := QuantizationSNR(x)
+		if snr < 20 || snr > 50 {
+			t.Errorf("QuantizationSNR(sinusoid) = %.1f dB, want between 20 and 50", snr)
+		}
+	})
+
+	tests := []struct {
+		name     string
+		x        []float32
+		expected float64
+	}{
+		{"all zeros", make([]float32, 16), math.Inf(1)},
+		{"empty", nil, math.Inf(1)},
+		{"exactly representable", []float32{1, -2, 0.5, 448}, math.Inf(1)},
+		{"non-finite ignored", []float32{1, float32(math.NaN()), float32(math.Inf(1)), 2}, math.Inf(1)},
+		{"overflow", []float32{1, 1000}, math.Inf(-1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := QuantizationSNR(tt.x); got != tt.expected {
+				t.Errorf("QuantizationSNR(%v) = %v, want %v", tt.x, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFidelityReport(t *testing.T) {
+	x := []float32{
+		1, -2, // exact
+		1.1,        // rounds to 1.125, error 0.025
+		-0.3,       // rounds to -0.3125, error 0.0125
+		1000, -600, // overflow
+		0.0001, // underflows to 0, error 0.0001
+		0,
+		float32(math.NaN()), float32(math.Inf(1)), // ignored
+	}
+	got := FidelityReport(x)
+
+	if got.OverflowCount != 2 || got.UnderflowCount != 1 {
+		t.Errorf("counts = (%d, %d), want (2, 1)", got.OverflowCount, got.UnderflowCount)
+	}
+
+	errs := []float64{0, 0, 1.125 - float64(float32(1.1)), 0.3125 - float64(float32(0.3)), float64(float32(0.0001)), 0}
+	var wantMax, sumAbs, sumSq float64
+	for _, e := range errs {
+		wantMax = math.Max(wantMax, e)
+		sumAbs += e
+		sumSq += e * e
+	}
+	for _, c := range []struct {
+		name      string
+		got, want float32
+	}{
+		{"MaxAbsError", got.MaxAbsError, float32(wantMax)},
+		{"MeanAbsError", got.MeanAbsError, float32(sumAbs / float64(len(errs)))},
+		{"RMSError", got.RMSError, float32(math.Sqrt(sumSq / float64(len(errs))))},
+	} {
+		if math.Abs(float64(c.got-c.want)) > 1e-6*float64(c.want) {
+			t.Errorf("%s = %v, want %v", c.name, c.got, c.want)
+		}
+	}
+
+	if got := FidelityReport([]float32{1, 0.5, -448}); got != (Fidelity{}) {
+		t.Errorf("FidelityReport(exact) = %+v, want zero", got)
+	}
+	if got := FidelityReport(nil); got != (Fidelity{}) {
+		t.Errorf("FidelityReport(nil) = %+v, want zero", got)
+	}
+	if got := FidelityReport([]float32{500}); got != (Fidelity{OverflowCount: 1}) {
+		t.Errorf("FidelityReport([500]) = %+v, want only an overflow", got)
+	}
+}
+
+func TestQuantizeDithered(t *testing.T) {
+	x := []float32{0.3, -1.0625, 2.5, float32(math.Copysign(0, -1)), 500, float32(math.NaN())}
+
+	// Zero dither matches ToSlice8
+	got, err := QuantizeDithered(x, make([]float32, len(x)))
+	if err != nil {
+		t.Fatalf("QuantizeDithered() error = %v", err)
+	}
+	want := ToSlice8(x)
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("QuantizeDithered(x, 0)[%d] = 0x%02x, want 0x%02x", i, uint8(got[i]), uint8(want[i]))
+		}
+	}
+
+	// Dither moves values across rounding boundaries: 1.0625 is the midpoint
+	// of 1 and 1.125, and 2.5 lies between 2.25 and 2.75
+	boundary := []float32{1.0625, 1.0625, 2.5, 2.5}
+	dither := []float32{-0.001, 0.001, -0.2, 0.2}
+	expected := []Float8{One(), ToFloat8(1.125), ToFloat8(2.25), ToFloat8(2.75)}
+	got, err = QuantizeDithered(boundary, dither)
+	if err != nil {
+		t.Fatalf("QuantizeDithered() error = %v", err)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("QuantizeDithered(%v, %v)[%d] = %v, want %v", boundary[i], dither[i], i, got[i], expected[i])
+		}
+	}
+
+	// The same dither gives the same result
+	again, _ := QuantizeDithered(boundary, dither)
+	for i := range got {
+		if again[i] != got[i] {
+			t.Errorf("QuantizeDithered is not reproducible at index %d", i)
+		}
+	}
+}
+
+func TestQuantizeDitheredErrors(t *testing.T) {
+	if _, err := QuantizeDithered([]float32{1, 2}, []float32{0}); err == nil {
+		t.Error("QuantizeDithered() with mismatched lengths returned no error")
+	}
+	if got, err := QuantizeDithered(nil, nil); got != nil || err != nil {
+		t.Errorf("QuantizeDithered(nil, nil) = %v, %v, want nil, nil", got, err)
+	}
+}
+
+func TestMuLaw(t *testing.T) {
+	mu := FromInt(255)
+	m := float64(mu.ToFloat32())
+
+	tests := []struct {
+		name       string
+		f          Float8
+		compressed Float8
+	}{
+		{"positive endpoint", One(), One()},
+		{"negative endpoint", FromInt(-1), FromInt(-1)},
+		{"positive zero", PositiveZero, PositiveZero},
+		{"negative zero", NegativeZero, NegativeZero},
+		{"small value", ToFloat8(0.015625), ToFloat8(float32(math.Log1p(m*0.015625) / math.Log1p(m)))},
+		{"negative value", ToFloat8(-0.25), ToFloat8(float32(-math.Log1p(m*0.25) / math.Log1p(m)))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MuLawCompress(tt.f, mu); got != tt.compressed {
+				t.Errorf("MuLawCompress(%v, %v) = %v, want %v", tt.f, mu, got, tt.compressed)
+			}
+		})
+	}
+
+	// Small magnitudes are spread over a wider range
+	if got := MuLawCompress(ToFloat8(0.015625), mu); got.ToFloat32() < 0.25 {
+		t.Errorf("MuLawCompress(0.015625) = %v, want at least 0.25", got)
+	}
+
+	// Expanding the compressed value recovers the input within one Float8
+	// step in the compressed domain
+	for _, f := range AllFiniteValues() {
+		x := f.ToFloat32()
+		if x < -1 || x > 1 {
+			continue
+		}
+		c := MuLawCompress(f, mu)
+		got := MuLawExpand(c, mu)
+		if !AlmostEqualULP(MuLawCompress(got, mu), c, 1) {
+			t.Errorf("MuLawExpand(MuLawCompress(%v)) = %v", f, got)
+		}
+		if math.Abs(float64(got.ToFloat32()-x)) > 0.17*math.Abs(float64(x)) {
+			t.Errorf("MuLawExpand(MuLawCompress(%v)) = %v, relative error above 17%%", f, got)
+		}
+	}
+	for _, f := range []Float8{One(), FromInt(-1), PositiveZero, NegativeZero} {
+		if got := MuLawExpand(f, mu); got != f {
+			t.Errorf("MuLawExpand(%v, %v) = %v, want %v", f, mu, got, f)
+		}
+	}
+}
+
+func TestMuLawInvalid(t *testing.T) {
+	for _, mu := range []Float8{PositiveZero, FromInt(-8), PositiveInfinity, NaN} {
+		if got := MuLawCompress(ToFloat8(0.5), mu); !got.IsNaN() {
+			t.Errorf("MuLawCompress(0.5, %v) = %v, want NaN", mu, got)
+		}
+		if got := MuLawExpand(ToFloat8(0.5), mu); !got.IsNaN() {
+			t.Errorf("MuLawExpand(0.5, %v) = %v, want NaN", mu, got)
+		}
+	}
+	if got := MuLawCompress(NaN, FromInt(255)); !got.IsNaN() {
+		t.Errorf("MuLawCompress(NaN, 255) = %v, want NaN", got)
+	}
+}
+
+func TestQuantizePerChannel(t *testing.T) {
+	// Rows and columns with very different magnitudes
+	x := [][]float32{
+		{0.001, -0.002, 0.0005, 0.0015},
+		{10, 20, -40, 5},
+		{-300, 100, 1000, 50},
+	}
+
+	for _, axis := range []int{0, 1} {
+		q, scales := QuantizePerChannel(x, axis)
+		channels := len(x)
+		if axis == 1 {
+			channels = len(x[0])
+		}
+		if len(scales) != channels {
+			t.Fatalf("axis %d: got %d scales, want %d", axis, len(scales), channels)
+		}
+
+		// Each channel's peak maps onto MaxValue
+		peaks := make([]Float8, channels)
+		for i, row := range q {
+			for j, v := range row {
+				c := i
+				if axis == 1 {
+					c = j
+				}
+				if Greater(v.Abs(), peaks[c]) {
+					peaks[c] = v.Abs()
+				}
+			}
+		}
+		for c, peak := range peaks {
+			if peak != MaxValue {
+				t.Errorf("axis %d: channel %d peak = %v, want %v", axis, c, peak, MaxValue)
+			}
+		}
+
+		// Dequantization reconstructs each element within its channel's error
+		got := DequantizePerChannel(q, scales, axis)
+		for i, row := range x {
+			for j, v := range row {
+				scale := scales[i]
+				if axis == 1 {
+					scale = scales[j]
+				}
+				tol := math.Abs(float64(v))/10 + float64(scale)/512
+				if diff := math.Abs(float64(got[i][j] - v)); diff > tol {
+					t.Errorf("axis %d: reconstructed [%d][%d] = %g, want %g (±%g)", axis, i, j, got[i][j], v, tol)
+				}
+			}
+		}
+	}
+}
+
+func TestQuantizePerChannelEdgeCases(t *testing.T) {
+	q, scales := QuantizePerChannel(nil, 1)
+	if len(q) != 0 || len(scales) != 0 {
+		t.Errorf("QuantizePerChannel(nil, 1) = %v, %v, want empty", q, scales)
+	}
+
+	// Row channels may be ragged, and an all-zero channel gets scale 1
+	q, scales = QuantizePerChannel([][]float32{{1, 2, 3}, {0}}, 0)
+	if len(q[1]) != 1 || scales[1] != 1 {
+		t.Errorf("QuantizePerChannel ragged rows = %v, %v", q, scales)
+	}
+
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"invalid axis", func() { QuantizePerChannel([][]float32{{1}}, 2) }},
+		{"ragged columns", func() { QuantizePerChannel([][]float32{{1, 2}, {3}}, 1) }},
+		{"dequantize invalid axis", func() { DequantizePerChannel([][]Float8{{One()}}, []float32{1}, -1) }},
+		{"dequantize scale count", func() { DequantizePerChannel([][]Float8{{One(), One()}}, []float32{1}, 1) }},
+		{"dequantize row scale count", func() { DequantizePerChannel([][]Float8{{One()}}, []float32{1, 2}, 0) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Expected panic but function completed successfully")
+				}
+			}()
+			tt.fn()
+		})
+	}
+}
+
+func TestQuantizeBlocks(t *testing.T) {
+	// Blocks of 4 with magnitudes spanning several orders, plus a partial block
+	x := []float32{
+		0.001, -0.004, 0.002, 0.003,
+		10, -20, 5, 1,
+		-300, 1000, 7, 0.5,
+		2, -3,
+	}
+	const blockSize = 4
+
+	q, scales := QuantizeBlocks(x, blockSize)
+	if len(q) != len(x) || len(scales) != 4 {
+		t.Fatalf("QuantizeBlocks() = %d values, %d scales, want %d, 4", len(q), len(scales), len(x))
+	}
+
+	for b := range scales {
+		start, end := b*blockSize, min((b+1)*blockSize, len(x))
+		if want := ComputeScale(x[start:end]); scales[b] != want {
+			t.Errorf("block %d scale = %g, want %g", b, scales[b], want)
+		}
+		var peak Float8
+		for _, v := range q[start:end] {
+			if Greater(v.Abs(), peak) {
+				peak = v.Abs()
+			}
+		}
+		if peak != MaxValue {
+			t.Errorf("block %d peak = %v, want %v", b, peak, MaxValue)
+		}
+	}
+
+	got := DequantizeBlocks(q, scales, blockSize)
+	for i := range x {
+		scale := scales[i/blockSize]
+		tol := math.Abs(float64(x[i]))/10 + float64(scale)/512
+		if diff := math.Abs(float64(got[i] - x[i])); diff > tol {
+			t.Errorf("DequantizeBlocks()[%d] = %g, want %g (±%g)", i, got[i], x[i], tol)
+		}
+	}
+}
+
+func TestQuantizeBlocksEdgeCases(t *testing.T) {
+	q, scales := QuantizeBlocks(nil, 8)
+	if len(q) != 0 || len(scales) != 0 {
+		t.Errorf("QuantizeBlocks(nil, 8) = %v, %v, want empty", q, scales)
+	}
+
+	// A block larger than the input is a single partial block
+	q, scales = QuantizeBlocks([]float32{1, -2}, 32)
+	if len(scales) != 1 || q[1] != MinValue {
+		t.Errorf("QuantizeBlocks([1 -2], 32) = %v, %v", q, scales)
+	}
+
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"zero block size", func() { QuantizeBlocks([]float32{1}, 0) }},
+		{"dequantize zero block size", func() { DequantizeBlocks([]Float8{One()}, []float32{1}, 0) }},
+		{"dequantize scale count", func() { DequantizeBlocks([]Float8{One(), One(), One()}, []float32{1}, 2) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Expected panic but function completed successfully")
+				}
+			}()
+			tt.fn()
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package float8
+
+import (
+	"math/rand/v2"
+)
+
+// Random value generation
+
+// RandFloat8 returns a Float8 drawn uniformly from all 256 bit patterns.
+//
+// The distribution is uniform over encodings, not over real values: because
+// the encoding is logarithmically spaced, values near zero are far more
+// likely than a uniform real draw would produce, and Inf and NaN patterns
+// are included. Use RandFiniteFloat8 for a uniform draw over the finite range.
+func RandFloat8(r *rand.Rand) Float8 {
+	return Float8(r.Uint32())
+}
+
+// RandFiniteFloat8 returns a finite Float8 obtained by drawing a real value
+// uniformly from [MinValue, MaxValue] and rounding it with ToFloat8.
+//
+// Each value is therefore returned with probability proportional to the width
+// of the interval that rounds to it, so large magnitudes dominate and
+// subnormals are rare.
+func RandFiniteFloat8(r *rand.Rand) Float8 {
+	maxVal := MaxValue.ToFloat32()
+	return ToFloat8((2*r.Float32() - 1) * maxVal)
+}
+
+// RandSlice8 returns n Float8 values drawn with RandFloat8.
+func RandSlice8(r *rand.Rand, n int) []Float8 {
+	result := make([]Float8, n)
+	for i := range result {
+		result[i] = RandFloat8(r)
+	}
+	return result
+}
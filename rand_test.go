@@ -0,0 +1,59 @@
+package float8
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func TestRandFloat8(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	var seen [256]bool
+	for i := 0; i < 20000; i++ {
+		seen[RandFloat8(r)] = true
+	}
+	for i, ok := range seen {
+		if !ok && Float8(i).IsFinite() {
+			t.Errorf("finite bit pattern 0x%02x never drawn", i)
+		}
+	}
+}
+
+func TestRandFiniteFloat8(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	var positive, negative int
+	for i := 0; i < 10000; i++ {
+		f := RandFiniteFloat8(r)
+		if !f.IsFinite() {
+			t.Fatalf("RandFiniteFloat8() = %v, want a finite value", f)
+		}
+		if f.Sign() > 0 {
+			positive++
+		} else if f.Sign() < 0 {
+			negative++
+		}
+	}
+	// A uniform real draw is symmetric around zero
+	if positive < 4500 || negative < 4500 {
+		t.Errorf("got %d positive and %d negative values, want roughly equal counts", positive, negative)
+	}
+}
+
+func TestRandSlice8(t *testing.T) {
+	a := RandSlice8(rand.New(rand.NewPCG(42, 7)), 64)
+	b := RandSlice8(rand.New(rand.NewPCG(42, 7)), 64)
+
+	if len(a) != 64 {
+		t.Fatalf("RandSlice8() length = %d, want 64", len(a))
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			t.Errorf("At index %d: 0x%02x != 0x%02x with the same seed", i, uint8(a[i]), uint8(b[i]))
+		}
+	}
+
+	if got := RandSlice8(rand.New(rand.NewPCG(42, 7)), 0); len(got) != 0 {
+		t.Errorf("RandSlice8(r, 0) length = %d, want 0", len(got))
+	}
+}
@@ -0,0 +1,119 @@
+package float8
+
+import (
+	"slices"
+)
+
+// Slice reshaping
+//
+// These functions rearrange elements without changing their values. Chunk
+// and Split return subslices that share the input's backing array, capped so
+// that appending to one part does not overwrite the next; the other functions
+// return newly allocated slices.
+
+// Reverse returns a new slice with the elements of s in reverse order.
+func Reverse(s []Float8) []Float8 {
+	result := slices.Clone(s)
+	slices.Reverse(result)
+	return result
+}
+
+// ReverseInPlace reverses the order of the elements of s.
+func ReverseInPlace(s []Float8) {
+	slices.Reverse(s)
+}
+
+// Concat returns a new slice holding the elements of each part in turn.
+func Concat(parts ...[]Float8) []Float8 {
+	total := 0
+	for _, s := range parts {
+		total += len(s)
+	}
+	result := make([]Float8, 0, total)
+	for _, s := range parts {
+		result = append(result, s...)
+	}
+	return result
+}
+
+// Chunk splits s into consecutive subslices of size elements each. If len(s)
+// is not a multiple of size, the last chunk holds the remaining
+// len(s) % size elements. An empty s yields no chunks.
+// Panics if size is less than 1.
+func Chunk(s []Float8, size int) [][]Float8 {
+	if size < 1 {
+		panic("float8: chunk size must be positive")
+	}
+	chunks := make([][]Float8, 0, (len(s)+size-1)/size)
+	for start := 0; start < len(s); start += size {
+		end := min(start+size, len(s))
+		chunks = append(chunks, s[start:end:end])
+	}
+	return chunks
+}
+
+// Split divides s into n consecutive subslices whose lengths differ by at
+// most one, with the longer ones first. If len(s) < n, the trailing parts
+// are empty. Panics if n is less than 1.
+func Split(s []Float8, n int) [][]Float8 {
+	if n < 1 {
+		panic("float8: split count must be positive")
+	}
+	parts := make([][]Float8, n)
+	size, extra := len(s)/n, len(s)%n
+	start := 0
+	for i := range parts {
+		end := start + size
+		if i < extra {
+			end++
+		}
+		parts[i] = s[start:end:end]
+		start = end
+	}
+	return parts
+}
+
+// Interleave merges equal-length channels into a single slice ordered
+// c0[0], c1[0], ..., c0[1], c1[1], ..., as used for multi-channel audio.
+// Interleave with no channels returns an empty slice.
+// Panics if the channels have different lengths.
+func Interleave(channels ...[]Float8) []Float8 {
+	if len(channels) == 0 {
+		return []Float8{}
+	}
+	frames := len(channels[0])
+	for _, c := range channels[1:] {
+		if len(c) != frames {
+			panic("float8: slice length mismatch")
+		}
+	}
+
+	result := make([]Float8, frames*len(channels))
+	for j, c := range channels {
+		for i, v := range c {
+			result[i*len(channels)+j] = v
+		}
+	}
+	return result
+}
+
+// Deinterleave splits an interleaved slice into n newly allocated channels,
+// inverting Interleave: element i of s goes to channel i % n.
+// Panics if n is less than 1 or len(s) is not a multiple of n.
+func Deinterleave(s []Float8, n int) [][]Float8 {
+	if n < 1 {
+		panic("float8: channel count must be positive")
+	}
+	if len(s)%n != 0 {
+		panic("float8: slice length mismatch")
+	}
+
+	channels := make([][]Float8, n)
+	for j := range channels {
+		channels[j] = make([]Float8, len(s)/n)
+	}
+	for i, v := range s {
+		channels[i%n][i/n] = v
+	}
+	return channels
+}
@@ -0,0 +1,220 @@
+package float8
+
+import (
+	"testing"
+)
+
+// equalBits reports whether a and b have the same length and bit patterns.
+func equalBits(a, b []Float8) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestReverse(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []Float8
+		expected []Float8
+	}{
+		{"empty", []Float8{}, []Float8{}},
+		{"single", []Float8{One()}, []Float8{One()}},
+		{"odd", []Float8{One(), FromInt(2), NaN}, []Float8{NaN, FromInt(2), One()}},
+		{"even", []Float8{NegativeZero, One(), FromInt(2), FromInt(3)}, []Float8{FromInt(3), FromInt(2), One(), NegativeZero}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := Concat(tt.input)
+			if got := Reverse(tt.input); !equalBits(got, tt.expected) {
+				t.Errorf("Reverse(%v) = %v, want %v", tt.input, got, tt.expected)
+			}
+			if !equalBits(tt.input, original) {
+				t.Errorf("Reverse modified its input to %v", tt.input)
+			}
+
+			ReverseInPlace(tt.input)
+			if !equalBits(tt.input, tt.expected) {
+				t.Errorf("ReverseInPlace(%v) = %v, want %v", original, tt.input, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConcat(t *testing.T) {
+	a := []Float8{One(), FromInt(2)}
+	b := []Float8{FromInt(3)}
+
+	if got := Concat(a, nil, b, []Float8{}); !equalBits(got, []Float8{One(), FromInt(2), FromInt(3)}) {
+		t.Errorf("Concat(%v, nil, %v, []) = %v, want [1 2 3]", a, b, got)
+	}
+	if got := Concat(); got == nil || len(got) != 0 {
+		t.Errorf("Concat() = %v, want empty slice", got)
+	}
+
+	got := Concat(a)
+	got[0] = NaN
+	if a[0] != One() {
+		t.Error("Concat result aliases its input")
+	}
+}
+
+func TestChunk(t *testing.T) {
+	s := []Float8{One(), FromInt(2), FromInt(3), FromInt(4), FromInt(5)}
+	tests := []struct {
+		name     string
+		input    []Float8
+		size     int
+		expected [][]Float8
+	}{
+		{"empty", []Float8{}, 2, [][]Float8{}},
+		{"single", []Float8{One()}, 3, [][]Float8{{One()}}},
+		{"even", s[:4], 2, [][]Float8{{One(), FromInt(2)}, {FromInt(3), FromInt(4)}}},
+		{"uneven", s, 2, [][]Float8{{One(), FromInt(2)}, {FromInt(3), FromInt(4)}, {FromInt(5)}}},
+		{"size larger than input", s, 8, [][]Float8{s}},
+		{"size one", s[:2], 1, [][]Float8{{One()}, {FromInt(2)}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Chunk(tt.input, tt.size)
+			if len(got) != len(tt.expected) {
+				t.Fatalf("Chunk(%v, %d) = %v, want %v", tt.input, tt.size, got, tt.expected)
+			}
+			for i := range got {
+				if !equalBits(got[i], tt.expected[i]) {
+					t.Errorf("Chunk(%v, %d)[%d] = %v, want %v", tt.input, tt.size, i, got[i], tt.expected[i])
+				}
+			}
+		})
+	}
+
+	// Appending to a chunk must not overwrite the next one
+	chunks := Chunk(Concat(s), 2)
+	_ = append(chunks[0], NaN)
+	if chunks[1][0] != FromInt(3) {
+		t.Error("append to a chunk overwrote the following chunk")
+	}
+}
+
+func TestSplit(t *testing.T) {
+	s := []Float8{One(), FromInt(2), FromInt(3), FromInt(4), FromInt(5)}
+	tests := []struct {
+		name     string
+		input    []Float8
+		n        int
+		expected [][]Float8
+	}{
+		{"empty", []Float8{}, 2, [][]Float8{{}, {}}},
+		{"single part", s, 1, [][]Float8{s}},
+		{"even", s[:4], 2, [][]Float8{{One(), FromInt(2)}, {FromInt(3), FromInt(4)}}},
+		{"uneven", s, 3, [][]Float8{{One(), FromInt(2)}, {FromInt(3), FromInt(4)}, {FromInt(5)}}},
+		{"more parts than elements", s[:2], 3, [][]Float8{{One()}, {FromInt(2)}, {}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Split(tt.input, tt.n)
+			if len(got) != len(tt.expected) {
+				t.Fatalf("Split(%v, %d) = %v, want %v", tt.input, tt.n, got, tt.expected)
+			}
+			for i := range got {
+				if !equalBits(got[i], tt.expected[i]) {
+					t.Errorf("Split(%v, %d)[%d] = %v, want %v", tt.input, tt.n, i, got[i], tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestChunkSplitInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"Chunk zero size", func() { Chunk([]Float8{One()}, 0) }},
+		{"Split zero parts", func() { Split([]Float8{One()}, 0) }},
+		{"Split negative parts", func() { Split(nil, -1) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Expected panic but function completed successfully")
+				}
+			}()
+			tt.fn()
+		})
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	left := []Float8{One(), FromInt(2), FromInt(3)}
+	right := []Float8{FromInt(-1), FromInt(-2), FromInt(-3)}
+
+	got := Interleave(left, right)
+	expected := []Float8{One(), FromInt(-1), FromInt(2), FromInt(-2), FromInt(3), FromInt(-3)}
+	if !equalBits(got, expected) {
+		t.Errorf("Interleave(%v, %v) = %v, want %v", left, right, got, expected)
+	}
+
+	channels := Deinterleave(got, 2)
+	if len(channels) != 2 || !equalBits(channels[0], left) || !equalBits(channels[1], right) {
+		t.Errorf("Deinterleave(%v, 2) = %v, want [%v %v]", got, channels, left, right)
+	}
+
+	t.Run("three channels", func(t *testing.T) {
+		c := [][]Float8{{One(), FromInt(4)}, {FromInt(2), FromInt(5)}, {FromInt(3), NaN}}
+		s := Interleave(c...)
+		if !equalBits(s, []Float8{One(), FromInt(2), FromInt(3), FromInt(4), FromInt(5), NaN}) {
+			t.Errorf("Interleave(%v) = %v", c, s)
+		}
+		back := Deinterleave(s, 3)
+		for j := range c {
+			if !equalBits(back[j], c[j]) {
+				t.Errorf("Deinterleave()[%d] = %v, want %v", j, back[j], c[j])
+			}
+		}
+	})
+
+	t.Run("edge cases", func(t *testing.T) {
+		if got := Interleave(); got == nil || len(got) != 0 {
+			t.Errorf("Interleave() = %v, want empty slice", got)
+		}
+		if got := Interleave(left); !equalBits(got, left) {
+			t.Errorf("Interleave(%v) = %v, want %v", left, got, left)
+		}
+		if got := Deinterleave(nil, 2); len(got) != 2 || len(got[0]) != 0 || len(got[1]) != 0 {
+			t.Errorf("Deinterleave(nil, 2) = %v, want two empty channels", got)
+		}
+	})
+}
+
+func TestInterleaveInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"ragged channels", func() { Interleave([]Float8{One()}, []Float8{One(), One()}) }},
+		{"indivisible length", func() { Deinterleave([]Float8{One(), One(), One()}, 2) }},
+		{"zero channels", func() { Deinterleave([]Float8{One()}, 0) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Expected panic but function completed successfully")
+				}
+			}()
+			tt.fn()
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package float8
+
+// Sequence operations
+//
+// These functions treat a slice as an ordered sequence. Running totals are
+// kept in float32 and each output element is rounded to Float8 on its own,
+// so the results are more accurate than chaining Add or Mul, whose every
+// intermediate is rounded to Float8.
+
+// CumSum returns the cumulative sum of s: element i is the sum of s[0]
+// through s[i], accumulated in float32 and rounded once.
+//
+// Once a NaN element is reached, it and every later element is NaN.
+// Returns nil if s is nil.
+func CumSum(s []Float8) []Float8 {
+	if s == nil {
+		return nil
+	}
+	result := make([]Float8, len(s))
+	var sum float32
+	for i, v := range s {
+		sum += v.ToFloat32()
+		result[i] = roundFloat32(sum, FlushToZero)
+	}
+	return result
+}
+
+// CumProd returns the cumulative product of s: element i is the product of
+// s[0] through s[i], accumulated in float32 and rounded once.
+//
+// Once a NaN element is reached, it and every later element is NaN.
+// Returns nil if s is nil.
+func CumProd(s []Float8) []Float8 {
+	if s == nil {
+		return nil
+	}
+	result := make([]Float8, len(s))
+	prod := float32(1)
+	for i, v := range s {
+		prod *= v.ToFloat32()
+		result[i] = roundFloat32(prod, FlushToZero)
+	}
+	return result
+}
+
+// Diff returns the first discrete difference of s, a slice of length
+// len(s)-1 whose element i is Sub(s[i+1], s[i]).
+//
+// Special values follow Sub, so a NaN or infinite element affects the two
+// differences it takes part in. Diff returns an empty slice if len(s) < 2.
+func Diff(s []Float8) []Float8 {
+	if len(s) < 2 {
+		return []Float8{}
+	}
+	result := make([]Float8, len(s)-1)
+	for i := range result {
+		result[i] = Sub(s[i+1], s[i])
+	}
+	return result
+}
+
+// DiffN returns the n-th discrete difference of s, obtained by applying Diff
+// n times, with length max(len(s)-n, 0). DiffN(s, 0) returns a copy of s.
+// It panics if n is negative.
+func DiffN(s []Float8, n int) []Float8 {
+	if n < 0 {
+		panic("float8: negative difference order")
+	}
+	result := append([]Float8{}, s...)
+	for ; n > 0; n-- {
+		result = Diff(result)
+	}
+	return result
+}
+
+// MovingAverage returns the trailing moving average of s over window
+// elements, as a slice of the same length as s.
+//
+// Element i is the mean of s[i-window+1] through s[i], computed in float32.
+// Near the start, where fewer than window elements are available, the window
+// shrinks to s[0] through s[i] instead of padding. A NaN element makes every
+// window that contains it NaN. MovingAverage panics if window is less than 1.
+func MovingAverage(s []Float8, window int) []Float8 {
+	if window < 1 {
+		panic("float8: moving average window must be positive")
+	}
+	result := make([]Float8, len(s))
+	for i := range s {
+		start := max(0, i-window+1)
+		var sum float32
+		for _, v := range s[start : i+1] {
+			sum += v.ToFloat32()
+		}
+		result[i] = roundFloat32(sum/float32(i+1-start), FlushToZero)
+	}
+	return result
+}
+
+// ExponentialMovingAverage returns the exponential moving average of s with
+// smoothing factor alpha, as a slice of the same length as s.
+//
+// The average starts at s[0] and is updated as alpha*s[i] + (1-alpha)*avg in
+// float32, so alpha should lie in [0, 1]: values near 1 follow the input
+// closely and values near 0 smooth heavily. Once a NaN element is reached,
+// it and every later element is NaN.
+func ExponentialMovingAverage(s []Float8, alpha Float8) []Float8 {
+	result := make([]Float8, len(s))
+	if len(s) == 0 {
+		return result
+	}
+	a := alpha.ToFloat32()
+	avg := s[0].ToFloat32()
+	result[0] = s[0]
+	for i := 1; i < len(s); i++ {
+		avg = a*s[i].ToFloat32() + (1-a)*avg
+		result[i] = roundFloat32(avg, FlushToZero)
+	}
+	return result
+}
+
+// ConvMode selects which outputs Conv1DWithMode produces.
+type ConvMode int
+
+const (
+	// ConvValid produces only outputs where the kernel fits entirely inside
+	// the signal: len(signal)-len(kernel)+1 elements, or none if the kernel
+	// is longer than the signal.
+	ConvValid ConvMode = iota
+	// ConvSame produces len(signal) outputs, with the kernel centered on each
+	// signal element and the signal zero-padded at both ends. For an even
+	// kernel length the extra element of the kernel falls after the center.
+	ConvSame
+	// ConvFull produces every output where the kernel overlaps the signal:
+	// len(signal)+len(kernel)-1 elements, zero-padding the signal at both ends.
+	ConvFull
+)
+
+// Conv1D returns the valid cross-correlation of signal with kernel.
+// It is equivalent to Conv1DWithMode(signal, kernel, ConvValid).
+func Conv1D(signal, kernel []Float8) []Float8 {
+	return Conv1DWithMode(signal, kernel, ConvValid)
+}
+
+// Conv1DWithMode returns the cross-correlation of signal with kernel, with
+// the output length determined by mode.
+//
+// Output element i is the sum over j of signal[start+i+j]*kernel[j], where
+// start is 0 for ConvValid, -(len(kernel)-1)/2 for ConvSame, and
+// -(len(kernel)-1) for ConvFull, and signal positions outside the slice
+// count as zero. As in most ML frameworks, the kernel is not flipped. Each
+// element is accumulated in float32 and rounded to Float8 once.
+//
+// Conv1DWithMode returns an empty slice if kernel is empty.
+func Conv1DWithMode(signal, kernel []Float8, mode ConvMode) []Float8 {
+	n, k := len(signal), len(kernel)
+	if k == 0 {
+		return []Float8{}
+	}
+
+	var start, length int
+	switch mode {
+	case ConvSame:
+		start, length = -(k-1)/2, n
+	case ConvFull:
+		start, length = -(k - 1), n+k-1
+	default:
+		start, length = 0, max(n-k+1, 0)
+	}
+
+	result := make([]Float8, length)
+	for i := range result {
+		var sum float32
+		for j, w := range kernel {
+			if pos := start + i + j; pos >= 0 && pos < n {
+				sum += signal[pos].ToFloat32() * w.ToFloat32()
+			}
+		}
+		result[i] = roundFloat32(sum, FlushToZero)
+	}
+	return result
+}
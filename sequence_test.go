@@ -0,0 +1,277 @@
+package float8
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCumSum(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        []Float8
+		expected []Float8
+	}{
+		{"nil", nil, nil},
+		{"empty", []Float8{}, []Float8{}},
+		{"integers", []Float8{One(), FromInt(2), FromInt(3), FromInt(-1)}, []Float8{One(), FromInt(3), FromInt(6), FromInt(5)}},
+		{"NaN propagates", []Float8{One(), NaN, One()}, []Float8{One(), NaN, NaN}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CumSum(tt.s)
+			if (got == nil) != (tt.expected == nil) || len(got) != len(tt.expected) {
+				t.Fatalf("CumSum(%v) = %v, want %v", tt.s, got, tt.expected)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] && !(got[i].IsNaN() && tt.expected[i].IsNaN()) {
+					t.Errorf("CumSum(%v)[%d] = %v, want %v", tt.s, i, got[i], tt.expected[i])
+				}
+			}
+		})
+	}
+
+	t.Run("monotonic on positive inputs", func(t *testing.T) {
+		s := Full(500, ToFloat8(0.1))
+		got := CumSum(s)
+		for i := 1; i < len(got); i++ {
+			if Less(got[i], got[i-1]) {
+				t.Fatalf("CumSum not monotonic at %d: %v after %v", i, got[i], got[i-1])
+			}
+		}
+		// Chained Add stalls long before the float32 running sum does
+		if last := got[len(got)-1]; !Less(SumSlice(s), last) {
+			t.Errorf("CumSum final = %v, want more than SumSlice = %v", last, SumSlice(s))
+		}
+	})
+}
+
+func TestCumProd(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        []Float8
+		expected []Float8
+	}{
+		{"nil", nil, nil},
+		{"integers", []Float8{FromInt(2), FromInt(3), FromInt(-1), ToFloat8(0.5)}, []Float8{FromInt(2), FromInt(6), FromInt(-6), FromInt(-3)}},
+		{"running product beyond range", []Float8{FromInt(32), FromInt(32), ToFloat8(0.25)}, []Float8{FromInt(32), PositiveInfinity, FromInt(256)}},
+		{"NaN propagates", []Float8{FromInt(2), NaN, FromInt(2)}, []Float8{FromInt(2), NaN, NaN}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CumProd(tt.s)
+			if len(got) != len(tt.expected) {
+				t.Fatalf("CumProd(%v) = %v, want %v", tt.s, got, tt.expected)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] && !(got[i].IsNaN() && tt.expected[i].IsNaN()) {
+					t.Errorf("CumProd(%v)[%d] = %v, want %v", tt.s, i, got[i], tt.expected[i])
+				}
+			}
+		})
+	}
+
+	if !slices.Equal(CumProd([]Float8{}), []Float8{}) {
+		t.Error("CumProd(empty) is not empty")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        []Float8
+		expected []Float8
+	}{
+		{"nil", nil, []Float8{}},
+		{"single", []Float8{One()}, []Float8{}},
+		{"integers", []Float8{One(), FromInt(3), FromInt(2), FromInt(6)}, []Float8{FromInt(2), FromInt(-1), FromInt(4)}},
+		{"NaN", []Float8{One(), NaN, FromInt(2), FromInt(3)}, []Float8{NaN, NaN, One()}},
+		{"infinity", []Float8{One(), PositiveInfinity, PositiveInfinity}, []Float8{PositiveInfinity, NaN}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Diff(tt.s)
+			if got == nil || len(got) != len(tt.expected) {
+				t.Fatalf("Diff(%v) = %v, want %v", tt.s, got, tt.expected)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] && !(got[i].IsNaN() && tt.expected[i].IsNaN()) {
+					t.Errorf("Diff(%v)[%d] = %v, want %v", tt.s, i, got[i], tt.expected[i])
+				}
+			}
+		})
+	}
+
+	t.Run("Linspace has a constant step", func(t *testing.T) {
+		step := ToFloat8(0.5)
+		for i, d := range Diff(Linspace(PositiveZero, FromInt(8), 17)) {
+			if !AlmostEqualULP(d, step, 1) {
+				t.Errorf("Diff(Linspace)[%d] = %v, want ≈%v", i, d, step)
+			}
+		}
+	})
+}
+
+func TestDiffN(t *testing.T) {
+	s := []Float8{PositiveZero, One(), FromInt(4), FromInt(9), FromInt(16)}
+
+	if got := DiffN(s, 2); !slices.Equal(got, Full(3, FromInt(2))) {
+		t.Errorf("DiffN(squares, 2) = %v, want [2 2 2]", got)
+	}
+	if got := DiffN(s, 0); !slices.Equal(got, s) {
+		t.Errorf("DiffN(s, 0) = %v, want %v", got, s)
+	}
+	if got := DiffN(s, 7); len(got) != 0 {
+		t.Errorf("DiffN(s, 7) = %v, want empty", got)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for negative order, but got none")
+		}
+	}()
+	_ = DiffN(s, -1)
+}
+
+// step returns n zeros followed by n ones.
+func step(n int) []Float8 {
+	return append(Zeros(n), Ones(n)...)
+}
+
+func TestMovingAverage(t *testing.T) {
+	t.Run("constant input unchanged", func(t *testing.T) {
+		s := Full(20, ToFloat8(3.5))
+		for _, window := range []int{1, 3, 20, 50} {
+			if got := MovingAverage(s, window); !slices.Equal(got, s) {
+				t.Errorf("MovingAverage(constant, %d) = %v, want %v", window, got, s)
+			}
+		}
+	})
+
+	t.Run("step smoothed monotonically", func(t *testing.T) {
+		got := MovingAverage(step(10), 4)
+		for i := 1; i < len(got); i++ {
+			if Less(got[i], got[i-1]) {
+				t.Errorf("MovingAverage(step, 4) not monotonic at %d: %v after %v", i, got[i], got[i-1])
+			}
+		}
+		expected := []Float8{ToFloat8(0.25), ToFloat8(0.5), ToFloat8(0.75), One()}
+		if !slices.Equal(got[10:14], expected) {
+			t.Errorf("MovingAverage(step, 4)[10:14] = %v, want %v", got[10:14], expected)
+		}
+	})
+
+	t.Run("shrinking window at the start", func(t *testing.T) {
+		got := MovingAverage([]Float8{FromInt(2), FromInt(4), FromInt(6), FromInt(8)}, 3)
+		expected := []Float8{FromInt(2), FromInt(3), FromInt(4), FromInt(6)}
+		if !slices.Equal(got, expected) {
+			t.Errorf("MovingAverage() = %v, want %v", got, expected)
+		}
+	})
+
+	t.Run("NaN affects windows containing it", func(t *testing.T) {
+		got := MovingAverage([]Float8{One(), NaN, One(), One(), One()}, 2)
+		for i, wantNaN := range []bool{false, true, true, false, false} {
+			if got[i].IsNaN() != wantNaN {
+				t.Errorf("MovingAverage()[%d] = %v, want NaN: %v", i, got[i], wantNaN)
+			}
+		}
+	})
+
+	t.Run("panic on non-positive window", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected panic for window 0, but got none")
+			}
+		}()
+		_ = MovingAverage(Ones(3), 0)
+	})
+}
+
+func TestExponentialMovingAverage(t *testing.T) {
+	t.Run("constant input unchanged", func(t *testing.T) {
+		s := Full(20, FromInt(-6))
+		if got := ExponentialMovingAverage(s, ToFloat8(0.25)); !slices.Equal(got, s) {
+			t.Errorf("ExponentialMovingAverage(constant) = %v, want %v", got, s)
+		}
+	})
+
+	t.Run("step smoothed monotonically", func(t *testing.T) {
+		got := ExponentialMovingAverage(step(10), ToFloat8(0.25))
+		for i := 1; i < len(got); i++ {
+			if Less(got[i], got[i-1]) {
+				t.Errorf("ExponentialMovingAverage(step) not monotonic at %d: %v after %v", i, got[i], got[i-1])
+			}
+		}
+		if got[10] != ToFloat8(0.25) || !Less(got[len(got)-1], One()) {
+			t.Errorf("ExponentialMovingAverage(step) = %v, want a gradual rise from 0.25", got[10:])
+		}
+	})
+
+	t.Run("alpha one follows the input", func(t *testing.T) {
+		s := []Float8{One(), FromInt(-3), FromInt(7)}
+		if got := ExponentialMovingAverage(s, One()); !slices.Equal(got, s) {
+			t.Errorf("ExponentialMovingAverage(s, 1) = %v, want %v", got, s)
+		}
+	})
+
+	if got := ExponentialMovingAverage(nil, One()); len(got) != 0 {
+		t.Errorf("ExponentialMovingAverage(nil) = %v, want empty", got)
+	}
+}
+
+func TestConv1D(t *testing.T) {
+	signal := []Float8{One(), FromInt(2), FromInt(3), FromInt(4), FromInt(5)}
+
+	t.Run("box kernel is a moving sum", func(t *testing.T) {
+		got := Conv1D(signal, Ones(3))
+		expected := []Float8{FromInt(6), FromInt(9), FromInt(12)}
+		if !slices.Equal(got, expected) {
+			t.Errorf("Conv1D(signal, box) = %v, want %v", got, expected)
+		}
+	})
+
+	t.Run("impulse reproduces the signal", func(t *testing.T) {
+		if got := Conv1D(signal, []Float8{One()}); !slices.Equal(got, signal) {
+			t.Errorf("Conv1D(signal, impulse) = %v, want %v", got, signal)
+		}
+		centered := []Float8{PositiveZero, One(), PositiveZero}
+		if got := Conv1DWithMode(signal, centered, ConvSame); !slices.Equal(got, signal) {
+			t.Errorf("Conv1DWithMode(signal, centered impulse, ConvSame) = %v, want %v", got, signal)
+		}
+	})
+
+	t.Run("cross-correlation does not flip the kernel", func(t *testing.T) {
+		got := Conv1D(signal, []Float8{One(), FromInt(-1)})
+		if !slices.Equal(got, Full(4, FromInt(-1))) {
+			t.Errorf("Conv1D(signal, [1 -1]) = %v, want [-1 -1 -1 -1]", got)
+		}
+	})
+
+	tests := []struct {
+		name     string
+		signal   []Float8
+		kernel   []Float8
+		mode     ConvMode
+		expected []Float8
+	}{
+		{"valid", signal, []Float8{One(), FromInt(2)}, ConvValid, []Float8{FromInt(5), FromInt(8), FromInt(11), FromInt(14)}},
+		{"same", signal, Ones(3), ConvSame, []Float8{FromInt(3), FromInt(6), FromInt(9), FromInt(12), FromInt(9)}},
+		{"same even kernel", signal, Ones(2), ConvSame, []Float8{FromInt(3), FromInt(5), FromInt(7), FromInt(9), FromInt(5)}},
+		{"full", signal, []Float8{One(), FromInt(2)}, ConvFull, []Float8{FromInt(2), FromInt(5), FromInt(8), FromInt(11), FromInt(14), FromInt(5)}},
+		{"kernel longer than signal", signal[:2], Ones(3), ConvValid, []Float8{}},
+		{"kernel longer than signal full", signal[:2], Ones(3), ConvFull, []Float8{One(), FromInt(3), FromInt(3), FromInt(2)}},
+		{"empty kernel", signal, nil, ConvFull, []Float8{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Conv1DWithMode(tt.signal, tt.kernel, tt.mode)
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("Conv1DWithMode(%v, %v, %d) = %v, want %v", tt.signal, tt.kernel, tt.mode, got, tt.expected)
+			}
+		})
+	}
+}
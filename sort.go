@@ -0,0 +1,260 @@
+package float8
+
+import (
+	"slices"
+	"sort"
+)
+
+// Sorting
+
+// Float8Slice attaches the methods of sort.Interface to []Float8, sorting in
+// increasing order by value with NaN values placed before all other values,
+// like sort.Float64Slice.
+//
+// Value order treats -0 and +0 as equal, so their relative order after a sort
+// depends on the input. Use SortTotalOrder for a bit-reproducible order.
+type Float8Slice []Float8
+
+func (x Float8Slice) Len() int { return len(x) }
+
+// Less reports whether x[i] should be ordered before x[j], as required by
+// the sort Interface. NaN values are ordered before all other values.
+func (x Float8Slice) Less(i, j int) bool {
+	return Less(x[i], x[j]) || (x[i].IsNaN() && !x[j].IsNaN())
+}
+
+func (x Float8Slice) Swap(i, j int) { x[i], x[j] = x[j], x[i] }
+
+// Sort is a convenience method: x.Sort() calls sort.Sort(x).
+func (x Float8Slice) Sort() { sort.Sort(x) }
+
+// TotalOrder compares a and b under the IEEE 754 totalOrder relation and
+// returns -1 if a precedes b, 0 if they are the same encoding, and +1 if a
+// follows b. It can be passed directly to slices.SortFunc.
+//
+// Every bit pattern has a distinct position:
+//
+//	-NaN < -Inf < negative finite < -0 < +0 < positive finite < +Inf < +NaN
+//
+// where -NaN is 0xFF and +NaN is 0x7F.
+func TotalOrder(a, b Float8) int {
+	ka, kb := totalOrderKey(a), totalOrderKey(b)
+	switch {
+	case ka < kb:
+		return -1
+	case ka > kb:
+		return 1
+	}
+	return 0
+}
+
+// TotalOrderMag compares the absolute values of a and b under the IEEE 754
+// totalOrderMag relation, TotalOrder(a.Abs(), b.Abs()), returning -1, 0, or
+// +1 like TotalOrder. It ignores signs, so -0 and +0 compare equal, as do x
+// and -x and the two NaN encodings:
+//
+//	0 < subnormal < normal < Inf < NaN
+//
+// Sorting with slices.SortFunc(s, TotalOrderMag) orders values by increasing
+// magnitude, as when summing small terms first to reduce rounding error.
+func TotalOrderMag(a, b Float8) int {
+	ka, kb := magnitudeRank(a), magnitudeRank(b)
+	switch {
+	case ka < kb:
+		return -1
+	case ka > kb:
+		return 1
+	}
+	return 0
+}
+
+// totalOrderKey maps f to an integer that increases with its totalOrder position.
+func totalOrderKey(f Float8) int {
+	if f&SignMask != 0 {
+		return -magnitudeRank(f) - 1
+	}
+	return magnitudeRank(f)
+}
+
+// magnitudeRank returns the position of |f| among the magnitudes in
+// increasing order: 0 for zero, 1 for SmallestPositive, up to MaxValue, then
+// infinity, then NaN.
+func magnitudeRank(f Float8) int {
+	// Magnitude encodings increase with value except that the infinity
+	// encoding 0x78 sits between 240 and 288, so move it after MaxValue
+	mag := int(f &^ SignMask)
+	switch {
+	case mag == int(PositiveInfinity):
+		return int(MaxValue)
+	case mag > int(PositiveInfinity) && mag <= int(MaxValue):
+		return mag - 1
+	}
+	return mag
+}
+
+// SortTotalOrder sorts s in place in increasing TotalOrder.
+//
+// Unlike Float8Slice.Sort, the result depends only on the multiset of bit
+// patterns in s, not on their input order: -0 always precedes +0 and the two
+// NaN encodings sort to the ends.
+func SortTotalOrder(s []Float8) {
+	slices.SortFunc(s, TotalOrder)
+}
+
+// Unique returns a new slice holding each distinct bit pattern in s once,
+// sorted in increasing TotalOrder. -0 and +0 are distinct, as are the two
+// NaN encodings. Returns nil if s is nil.
+func Unique(s []Float8) []Float8 {
+	if s == nil {
+		return nil
+	}
+	result := slices.Clone(s)
+	SortTotalOrder(result)
+	return slices.Compact(result)
+}
+
+// UniqueStable returns a new slice holding each distinct value in s once, in
+// the order of its first occurrence. Unlike Unique, values are compared by
+// value rather than bit pattern: -0 and +0 count as one value, and so do the
+// two NaN encodings. The encoding that occurs first is kept. Returns nil if s
+// is nil.
+func UniqueStable(s []Float8) []Float8 {
+	if s == nil {
+		return nil
+	}
+	var seen [256]bool
+	result := make([]Float8, 0, min(len(s), len(seen)))
+	for _, v := range s {
+		key := v
+		switch {
+		case v.IsZero():
+			key = PositiveZero
+		case v.IsNaN():
+			key = NaN
+		}
+		if !seen[key] {
+			seen[key] = true
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// IsMonotonicIncreasing reports whether s is in non-decreasing value order,
+// with each element not Less than the one before it. Signed zeros compare
+// equal. It returns false if s contains a NaN, and true if len(s) < 2.
+func IsMonotonicIncreasing(s []Float8) bool {
+	return monotonic(s, func(prev, next Float8) bool { return !Less(next, prev) })
+}
+
+// IsStrictlyIncreasing reports whether each element of s is Greater than the
+// one before it. NaN is handled as in IsMonotonicIncreasing.
+func IsStrictlyIncreasing(s []Float8) bool {
+	return monotonic(s, func(prev, next Float8) bool { return Greater(next, prev) })
+}
+
+// IsMonotonicDecreasing reports whether s is in non-increasing value order,
+// with each element not Greater than the one before it. NaN is handled as in
+// IsMonotonicIncreasing.
+func IsMonotonicDecreasing(s []Float8) bool {
+	return monotonic(s, func(prev, next Float8) bool { return !Greater(next, prev) })
+}
+
+// IsStrictlyDecreasing reports whether each element of s is Less than the one
+// before it. NaN is handled as in IsMonotonicIncreasing.
+func IsStrictlyDecreasing(s []Float8) bool {
+	return monotonic(s, func(prev, next Float8) bool { return Less(next, prev) })
+}
+
+// monotonic reports whether ordered holds for every adjacent pair of s and
+// no element is NaN.
+func monotonic(s []Float8, ordered func(prev, next Float8) bool) bool {
+	for i, v := range s {
+		if v.IsNaN() {
+			return false
+		}
+		if i > 0 && !ordered(s[i-1], v) {
+			return false
+		}
+	}
+	return true
+}
+
+// TopK returns the k largest elements of s in descending order together with
+// their indices in s.
+//
+// NaN elements are excluded, and k is clamped to the number of non-NaN
+// elements, so fewer than k values may be returned. Equal values are ordered
+// by increasing index, and -0 and +0 compare equal. TopK runs in
+// O(n log k) time using a bounded heap rather than sorting all of s.
+func TopK(s []Float8, k int) (values []Float8, indices []int) {
+	if k <= 0 {
+		return nil, nil
+	}
+	k = min(k, len(s))
+
+	// h is a min-heap of indices whose root is the weakest candidate kept so far
+	h := make([]int, 0, k)
+	for i, v := range s {
+		if v.IsNaN() {
+			continue
+		}
+		if len(h) < k {
+			h = append(h, i)
+			siftUp(s, h, len(h)-1)
+		} else if topKBefore(s, i, h[0]) {
+			h[0] = i
+			siftDown(s, h, 0)
+		}
+	}
+
+	slices.SortFunc(h, func(a, b int) int {
+		if topKBefore(s, a, b) {
+			return -1
+		}
+		return 1
+	})
+	values = make([]Float8, len(h))
+	for i, idx := range h {
+		values[i] = s[idx]
+	}
+	return values, h
+}
+
+// topKBefore reports whether s[i] ranks ahead of s[j] in TopK order: a larger
+// value, or an equal value at a lower index.
+func topKBefore(s []Float8, i, j int) bool {
+	if Less(s[j], s[i]) {
+		return true
+	}
+	return !Less(s[i], s[j]) && i < j
+}
+
+// siftUp restores the heap order of h after the element at i was appended.
+func siftUp(s []Float8, h []int, i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !topKBefore(s, h[parent], h[i]) {
+			break
+		}
+		h[parent], h[i] = h[i], h[parent]
+		i = parent
+	}
+}
+
+// siftDown restores the heap order of h after the element at i was replaced.
+func siftDown(s []Float8, h []int, i int) {
+	for {
+		weakest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(h) && topKBefore(s, h[weakest], h[child]) {
+				weakest = child
+			}
+		}
+		if weakest == i {
+			return
+		}
+		h[i], h[weakest] = h[weakest], h[i]
+		i = weakest
+	}
+}
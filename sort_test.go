@@ -0,0 +1,245 @@
+package float8
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestFloat8SliceSort(t *testing.T) {
+	s := Float8Slice{FromInt(3), NaN, FromInt(-1), PositiveInfinity, PositiveZero, NegativeInfinity, ToFloat8(0.5)}
+	s.Sort()
+
+	expected := []Float8{NaN, NegativeInfinity, FromInt(-1), PositiveZero, ToFloat8(0.5), FromInt(3), PositiveInfinity}
+	for i := range s {
+		if s[i] != expected[i] {
+			t.Errorf("At index %d: got %v, want %v", i, s[i], expected[i])
+		}
+	}
+}
+
+func TestTotalOrder(t *testing.T) {
+	// Every bit pattern in increasing total order
+	ordered := []Float8{0xFF, NegativeInfinity}
+	ordered = append(ordered, AllFiniteValues()...)
+	ordered = append(ordered, PositiveInfinity, NaN)
+
+	if len(ordered) != 256 {
+		t.Fatalf("ordered list has %d values, want 256", len(ordered))
+	}
+	for i := range ordered {
+		if got := TotalOrder(ordered[i], ordered[i]); got != 0 {
+			t.Errorf("TotalOrder(0x%02x, 0x%02x) = %d, want 0", uint8(ordered[i]), uint8(ordered[i]), got)
+		}
+		if i == 0 {
+			continue
+		}
+		if got := TotalOrder(ordered[i-1], ordered[i]); got != -1 {
+			t.Errorf("TotalOrder(0x%02x, 0x%02x) = %d, want -1", uint8(ordered[i-1]), uint8(ordered[i]), got)
+		}
+		if got := TotalOrder(ordered[i], ordered[i-1]); got != 1 {
+			t.Errorf("TotalOrder(0x%02x, 0x%02x) = %d, want 1", uint8(ordered[i]), uint8(ordered[i-1]), got)
+		}
+	}
+}
+
+func TestTotalOrderMag(t *testing.T) {
+	// Magnitudes in increasing order, with NaN last
+	var ordered []Float8
+	for _, f := range AllFiniteValues() {
+		if !f.SignBit() {
+			ordered = append(ordered, f)
+		}
+	}
+	ordered = append(ordered, PositiveInfinity, NaN)
+
+	for i, a := range ordered {
+		for j, b := range ordered {
+			want := 0
+			switch {
+			case i < j:
+				want = -1
+			case i > j:
+				want = 1
+			}
+			// Signs never matter
+			for _, pair := range [][2]Float8{{a, b}, {a | SignMask, b}, {a, b | SignMask}, {a | SignMask, b | SignMask}} {
+				if got := TotalOrderMag(pair[0], pair[1]); got != want {
+					t.Fatalf("TotalOrderMag(0x%02x, 0x%02x) = %d, want %d", uint8(pair[0]), uint8(pair[1]), got, want)
+				}
+			}
+			if got := TotalOrderMag(a, b); got != TotalOrder(a.Abs(), b.Abs()) {
+				t.Fatalf("TotalOrderMag(0x%02x, 0x%02x) = %d, want TotalOrder of magnitudes", uint8(a), uint8(b), got)
+			}
+		}
+	}
+
+	s := []Float8{FromInt(-3), NaN, One(), NegativeInfinity, NegativeZero, ToFloat8(-0.5), SmallestPositive}
+	slices.SortStableFunc(s, TotalOrderMag)
+	want := []Float8{NegativeZero, SmallestPositive, ToFloat8(-0.5), One(), FromInt(-3), NegativeInfinity, NaN}
+	if !slices.Equal(s, want) {
+		t.Errorf("sorted by TotalOrderMag = %v, want %v", s, want)
+	}
+}
+
+func TestSortTotalOrder(t *testing.T) {
+	input := []Float8{One(), PositiveZero, NegativeZero, NaN, 0xFF, FromInt(-2), PositiveZero, NegativeZero, NaN, PositiveInfinity}
+	expected := []Float8{0xFF, FromInt(-2), NegativeZero, NegativeZero, PositiveZero, PositiveZero, One(), PositiveInfinity, NaN, NaN}
+
+	r := rand.New(rand.NewPCG(3, 4))
+	for trial := 0; trial < 50; trial++ {
+		s := slices.Clone(input)
+		r.Shuffle(len(s), func(i, j int) { s[i], s[j] = s[j], s[i] })
+		SortTotalOrder(s)
+		if !slices.Equal(s, expected) {
+			t.Fatalf("SortTotalOrder() = %x, want %x", SliceAsBytes(s), SliceAsBytes(expected))
+		}
+	}
+}
+
+func TestUnique(t *testing.T) {
+	input := []Float8{One(), NegativeZero, PositiveZero, NaN, One(), 0xFF, NegativeZero, NaN, FromInt(-2)}
+	expected := []Float8{0xFF, FromInt(-2), NegativeZero, PositiveZero, One(), NaN}
+
+	got := Unique(input)
+	if !slices.Equal(got, expected) {
+		t.Errorf("Unique() = %x, want %x", SliceAsBytes(got), SliceAsBytes(expected))
+	}
+	if input[0] != One() || len(input) != 9 {
+		t.Error("Unique modified its input")
+	}
+
+	if Unique(nil) != nil {
+		t.Error("Unique(nil) is not nil")
+	}
+	if got := Unique(AllValues()); len(got) != 256 {
+		t.Errorf("len(Unique(AllValues())) = %d, want 256", len(got))
+	}
+}
+
+func TestUniqueStable(t *testing.T) {
+	input := []Float8{FromInt(3), NegativeZero, One(), FromInt(3), PositiveZero, 0xFF, FromInt(-2), NaN, One()}
+	expected := []Float8{FromInt(3), NegativeZero, One(), 0xFF, FromInt(-2)}
+
+	got := UniqueStable(input)
+	if !slices.Equal(got, expected) {
+		t.Errorf("UniqueStable() = %x, want %x", SliceAsBytes(got), SliceAsBytes(expected))
+	}
+	if input[0] != FromInt(3) || len(input) != 9 {
+		t.Error("UniqueStable modified its input")
+	}
+
+	if got := UniqueStable([]Float8{PositiveZero, NegativeZero}); !slices.Equal(got, []Float8{PositiveZero}) {
+		t.Errorf("UniqueStable([+0 -0]) = %v, want [+0]", got)
+	}
+	if UniqueStable(nil) != nil {
+		t.Error("UniqueStable(nil) is not nil")
+	}
+	if got := UniqueStable([]Float8{}); got == nil || len(got) != 0 {
+		t.Errorf("UniqueStable([]) = %v, want empty non-nil", got)
+	}
+	if got := UniqueStable(AllValues()); len(got) != 254 {
+		t.Errorf("len(UniqueStable(AllValues())) = %d, want 254", len(got))
+	}
+}
+
+// bruteForceTopK returns the expected TopK result by sorting every non-NaN index.
+func bruteForceTopK(s []Float8, k int) ([]Float8, []int) {
+	var indices []int
+	for i, v := range s {
+		if !v.IsNaN() {
+			indices = append(indices, i)
+		}
+	}
+	slices.SortStableFunc(indices, func(a, b int) int {
+		switch {
+		case Less(s[b], s[a]):
+			return -1
+		case Less(s[a], s[b]):
+			return 1
+		}
+		return 0
+	})
+	indices = indices[:min(k, len(indices))]
+	values := make([]Float8, len(indices))
+	for i, idx := range indices {
+		values[i] = s[idx]
+	}
+	return values, indices
+}
+
+func TestTopK(t *testing.T) {
+	r := rand.New(rand.NewPCG(5, 6))
+	for trial := 0; trial < 200; trial++ {
+		s := RandSlice8(r, r.IntN(64))
+		k := r.IntN(70)
+
+		values, indices := TopK(s, k)
+		wantValues, wantIndices := bruteForceTopK(s, k)
+		if !slices.Equal(values, wantValues) || !slices.Equal(indices, wantIndices) {
+			t.Fatalf("TopK(%x, %d) = %v, %v, want %v, %v",
+				SliceAsBytes(s), k, values, indices, wantValues, wantIndices)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		s       []Float8
+		k       int
+		values  []Float8
+		indices []int
+	}{
+		{"k larger than slice", []Float8{One(), FromInt(3), FromInt(2)}, 10, []Float8{FromInt(3), FromInt(2), One()}, []int{1, 2, 0}},
+		{"ties by index", []Float8{FromInt(2), FromInt(5), FromInt(2), FromInt(5)}, 3, []Float8{FromInt(5), FromInt(5), FromInt(2)}, []int{1, 3, 0}},
+		{"NaN excluded", []Float8{NaN, One(), NaN}, 3, []Float8{One()}, []int{1}},
+		{"zero k", []Float8{One()}, 0, nil, nil},
+		{"negative k", []Float8{One()}, -1, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, indices := TopK(tt.s, tt.k)
+			if !slices.Equal(values, tt.values) || !slices.Equal(indices, tt.indices) {
+				t.Errorf("TopK(%v, %d) = %v, %v, want %v, %v", tt.s, tt.k, values, indices, tt.values, tt.indices)
+			}
+		})
+	}
+}
+
+func TestIsMonotonic(t *testing.T) {
+	tests := []struct {
+		name                               string
+		s                                  []Float8
+		incr, strictIncr, decr, strictDecr bool
+	}{
+		{"empty", nil, true, true, true, true},
+		{"single", []Float8{One()}, true, true, true, true},
+		{"strictly increasing", []Float8{NegativeInfinity, FromInt(-2), PositiveZero, One(), MaxValue}, true, true, false, false},
+		{"equal adjacent", []Float8{One(), FromInt(2), FromInt(2), FromInt(3)}, true, false, false, false},
+		{"signed zeros", []Float8{NegativeZero, PositiveZero}, true, false, true, false},
+		{"strictly decreasing", []Float8{FromInt(3), One(), FromInt(-1)}, false, false, true, true},
+		{"non-strictly decreasing", []Float8{FromInt(3), FromInt(3), One()}, false, false, true, false},
+		{"unordered", []Float8{One(), FromInt(3), FromInt(2)}, false, false, false, false},
+		{"NaN breaks monotonicity", []Float8{One(), NaN, FromInt(3)}, false, false, false, false},
+		{"single NaN", []Float8{NegativeNaN}, false, false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, c := range []struct {
+				name string
+				got  bool
+				want bool
+			}{
+				{"IsMonotonicIncreasing", IsMonotonicIncreasing(tt.s), tt.incr},
+				{"IsStrictlyIncreasing", IsStrictlyIncreasing(tt.s), tt.strictIncr},
+				{"IsMonotonicDecreasing", IsMonotonicDecreasing(tt.s), tt.decr},
+				{"IsStrictlyDecreasing", IsStrictlyDecreasing(tt.s), tt.strictDecr},
+			} {
+				if c.got != c.want {
+					t.Errorf("%s(%v) = %v, want %v", c.name, tt.s, c.got, c.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,370 @@
+package float8
+
+import (
+	"math"
+	"sort"
+)
+
+// Slice statistics
+//
+// The statistics below accumulate in float32 and round the result to Float8
+// once, so they do not suffer from the precision loss of chaining Float8
+// additions.
+
+// MeanSlice returns the arithmetic mean of the elements in s.
+//
+// Special cases are:
+//
+//	MeanSlice([]) = +0
+//	MeanSlice(s) = NaN if any element is NaN
+func MeanSlice(s []Float8) Float8 {
+	if len(s) == 0 {
+		return PositiveZero
+	}
+	mean, _ := welford(s)
+	return roundFloat32(mean, FlushToZero)
+}
+
+// Variance returns the population variance of the elements in s, computed
+// with Welford's single-pass algorithm.
+//
+// The population variance divides the sum of squared deviations by len(s),
+// not len(s)-1.
+//
+// Special cases are:
+//
+//	Variance(s) = +0 if len(s) < 2
+//	Variance(s) = NaN if any element is NaN or infinite
+func Variance(s []Float8) Float8 {
+	if len(s) < 2 {
+		return PositiveZero
+	}
+	_, m2 := welford(s)
+	return roundFloat32(m2/float32(len(s)), FlushToZero)
+}
+
+// StdDev returns the population standard deviation of the elements in s,
+// the square root of Variance(s).
+//
+// Special cases are the same as for Variance.
+func StdDev(s []Float8) Float8 {
+	if len(s) < 2 {
+		return PositiveZero
+	}
+	_, m2 := welford(s)
+	return roundFloat32(float32(math.Sqrt(float64(m2/float32(len(s))))), FlushToZero)
+}
+
+// Median returns the middle element of s in value order, or the mean of the
+// two middle elements if len(s) is even. It is the same as Quantile(s, 0.5).
+//
+// Special cases are the same as for Quantile.
+func Median(s []Float8) Float8 {
+	return Quantile(s, 0.5)
+}
+
+// Quantile returns the q-quantile of s for q in [0, 1]. It sorts a copy of s
+// in value order and, for the rank q·(len(s)-1), interpolates linearly in
+// float32 between the neighboring elements, rounding the result once.
+// Quantile(s, 0) is the smallest element and Quantile(s, 1) the largest.
+//
+// Special cases are:
+//
+//	Quantile([], q) = +0
+//	Quantile(s, q) = NaN if any element is NaN
+//	Quantile(s, q) = NaN if q is NaN or outside [0, 1]
+//	Quantile(s, q) = NaN if it interpolates between -Inf and +Inf
+//	Quantile(s, q) = -Inf if it interpolates between -Inf and a finite value
+//	Quantile(s, q) = +Inf if it interpolates between a finite value and +Inf
+func Quantile(s []Float8, q float64) Float8 {
+	if !(q >= 0 && q <= 1) {
+		return NaN
+	}
+	if len(s) == 0 {
+		return PositiveZero
+	}
+
+	sorted := make(Float8Slice, len(s))
+	for i, v := range s {
+		if v.IsNaN() {
+			return NaN
+		}
+		sorted[i] = v
+	}
+	sort.Sort(sorted)
+
+	rank := q * float64(len(s)-1)
+	i := int(rank)
+	frac := float32(rank - float64(i))
+	if frac == 0 || Equal(sorted[i], sorted[i+1]) {
+		return sorted[i]
+	}
+	// Interpolating towards an infinity in float32 gives -Inf + Inf = NaN, so
+	// an infinite neighbor is returned as is
+	switch lo, hi := sorted[i], sorted[i+1]; {
+	case lo == NegativeInfinity && hi == PositiveInfinity:
+		return NaN
+	case lo == NegativeInfinity:
+		return lo
+	case hi == PositiveInfinity:
+		return hi
+	}
+	lo, hi := sorted[i].ToFloat32(), sorted[i+1].ToFloat32()
+	return roundFloat32(lo+frac*(hi-lo), FlushToZero)
+}
+
+// WeightedSum returns Σ values[i]·weights[i], accumulated in float32 and
+// rounded once. Panics if the slices have different lengths.
+func WeightedSum(values, weights []Float8) Float8 {
+	if len(values) != len(weights) {
+		panic("float8: slice length mismatch")
+	}
+	return roundFloat32(dot(values, weights), FlushToZero)
+}
+
+// WeightedMean returns Σ values[i]·weights[i] / Σ weights[i], with both sums
+// accumulated in float32 and the quotient rounded once.
+// Panics if the slices have different lengths.
+//
+// Special cases are:
+//
+//	WeightedMean(values, weights) = NaN if the weights sum to zero, including
+//	when the slices are empty
+//	WeightedMean(values, weights) = NaN if any value or weight is NaN
+func WeightedMean(values, weights []Float8) Float8 {
+	if len(values) != len(weights) {
+		panic("float8: slice length mismatch")
+	}
+	var total float32
+	for _, w := range weights {
+		total += w.ToFloat32()
+	}
+	if total == 0 {
+		return NaN
+	}
+	return roundFloat32(dot(values, weights)/total, FlushToZero)
+}
+
+// welford returns the running mean and the sum of squared deviations from the
+// mean of s, accumulated in float32.
+func welford(s []Float8) (mean, m2 float32) {
+	for i, v := range s {
+		x := v.ToFloat32()
+		delta := x - mean
+		mean += delta / float32(i+1)
+		m2 += delta * (x - mean)
+	}
+	return mean, m2
+}
+
+// Argmax returns the index of the first largest element of s by Less order.
+//
+// NaN elements are skipped. Argmax returns -1 if s is empty or contains only NaN.
+func Argmax(s []Float8) int {
+	return argExtreme(s, Greater)
+}
+
+// Argmin returns the index of the first smallest element of s by Less order.
+//
+// NaN elements are skipped. Argmin returns -1 if s is empty or contains only NaN.
+func Argmin(s []Float8) int {
+	return argExtreme(s, Less)
+}
+
+// argExtreme returns the index of the first non-NaN element of s that no
+// other element beats under better, or -1 if every element is NaN.
+func argExtreme(s []Float8, better func(a, b Float8) bool) int {
+	best := -1
+	for i, v := range s {
+		if v.IsNaN() {
+			continue
+		}
+		if best < 0 || better(v, s[best]) {
+			best = i
+		}
+	}
+	return best
+}
+
+// Histogram returns the number of occurrences of each bit pattern in s,
+// indexed by the pattern.
+func Histogram(s []Float8) [256]int {
+	var counts [256]int
+	for _, v := range s {
+		counts[v]++
+	}
+	return counts
+}
+
+// HistogramByValue returns the number of occurrences of each distinct value
+// in s. Encodings of the same value are merged: -0 is counted under
+// PositiveZero and both NaN encodings under NaN. Values that do not occur
+// have no entry.
+func HistogramByValue(s []Float8) map[Float8]int {
+	counts := make(map[Float8]int)
+	for _, v := range s {
+		switch {
+		case v.IsZero():
+			v = PositiveZero
+		case v.IsNaN():
+			v = NaN
+		}
+		counts[v]++
+	}
+	return counts
+}
+
+// Mode returns the most frequent value in s and the number of times it
+// occurs, counted with Histogram. Signed zeros count as the same value and
+// Mode reports them as PositiveZero. If several values are equally frequent,
+// the smallest by Less order is returned.
+//
+// NaN elements are ignored. Mode returns (NaN, 0) if s is empty or contains
+// only NaN.
+func Mode(s []Float8) (value Float8, count int) {
+	counts := Histogram(s)
+	counts[PositiveZero] += counts[NegativeZero]
+	counts[NegativeZero] = 0
+
+	value = NaN
+	for i, c := range counts {
+		v := Float8(i)
+		if c == 0 || v.IsNaN() {
+			continue
+		}
+		if c > count || (c == count && Less(v, value)) {
+			value, count = v, c
+		}
+	}
+	return value, count
+}
+
+// CountNaN returns the number of NaN elements in s, counting both encodings.
+func CountNaN(s []Float8) int {
+	nan, _, _, _ := SpecialCounts(s)
+	return nan
+}
+
+// CountInf returns the number of infinite elements in s, of either sign.
+func CountInf(s []Float8) int {
+	_, inf, _, _ := SpecialCounts(s)
+	return inf
+}
+
+// CountZero returns the number of zero elements in s, counting both signed zeros.
+func CountZero(s []Float8) int {
+	_, _, zero, _ := SpecialCounts(s)
+	return zero
+}
+
+// SpecialCounts returns the number of NaN, infinite, zero, and subnormal
+// elements in s in a single pass.
+func SpecialCounts(s []Float8) (nan, inf, zero, subnormal int) {
+	for _, v := range s {
+		switch v.Classify() {
+		case ClassNaN:
+			nan++
+		case ClassInfinity:
+			inf++
+		case ClassZero:
+			zero++
+		case ClassSubnormal:
+			subnormal++
+		}
+	}
+	return nan, inf, zero, subnormal
+}
+
+// NormalizeMinMax maps s linearly onto [0, 1], sending its smallest finite
+// element to 0 and its largest to 1. It returns the normalized values along
+// with the transform: offset is the smallest finite element and scale the
+// width of the finite range, so out[i] ≈ (s[i]-offset)/scale and the mapping
+// can be applied to other data or inverted with out[i]*scale + offset.
+//
+// The range and each output element are computed in float32 and rounded
+// once. offset is exact, but scale is rounded to Float8, so applying the
+// returned transform reproduces out only within 8-bit tolerance, and a range
+// wider than MaxValue makes scale overflow to +Inf.
+//
+// Special cases are:
+//   - NaN elements are ignored when finding the range and stay NaN
+//   - Infinite elements are ignored when finding the range and map to ±Inf
+//   - If every finite element is equal, every finite element maps to 0,
+//     scale is +0, and offset is that element
+//   - If there are no finite elements, scale and offset are both +0
+//   - A nil s returns nil
+func NormalizeMinMax(s []Float8) (out []Float8, scale, offset Float8) {
+	if s == nil {
+		return nil, PositiveZero, PositiveZero
+	}
+
+	offset = PositiveZero
+	lo, hi := float32(math.Inf(1)), float32(math.Inf(-1))
+	for _, v := range s {
+		if v.IsFinite() {
+			f32 := v.ToFloat32()
+			if f32 < lo {
+				lo, offset = f32, v
+			}
+			hi = max(hi, f32)
+		}
+	}
+
+	var inv float32
+	if hi > lo {
+		inv = 1 / (hi - lo)
+		scale = roundFloat32(hi-lo, FlushToZero)
+	}
+
+	out = make([]Float8, len(s))
+	for i, v := range s {
+		switch {
+		case v.IsNaN():
+			out[i] = v
+		case v.IsInf():
+			out[i] = CopySign(PositiveInfinity, v)
+		default:
+			out[i] = roundFloat32((v.ToFloat32()-lo)*inv, FlushToZero)
+		}
+	}
+	return out, scale, offset
+}
+
+// Standardize returns the z-scores (s[i]-mean)/std of the elements in s,
+// along with the population mean and standard deviation so the transform
+// can be inverted with out[i]*std + mean.
+//
+// The mean and standard deviation are accumulated in float32 with Welford's
+// algorithm, and each z-score is computed from them in float32 and rounded
+// once, so every output element is within half a Float8 step (a relative
+// error of at most 1/16) of its exact z-score. The returned mean and std are
+// rounded to Float8 as well, so inverting reproduces s only to within a few
+// steps.
+//
+// Special cases are:
+//   - If the variance is zero, including when len(s) == 1, every element
+//     maps to 0 and std is +0
+//   - If any element is NaN or infinite, every output is NaN
+//   - An empty s returns an empty slice, or nil if s is nil, with a mean and
+//     std of +0
+func Standardize(s []Float8) (out []Float8, mean, std Float8) {
+	if len(s) == 0 {
+		if s != nil {
+			out = []Float8{}
+		}
+		return out, PositiveZero, PositiveZero
+	}
+
+	mean32, m2 := welford(s)
+	std32 := float32(math.Sqrt(float64(m2 / float32(len(s)))))
+
+	out = make([]Float8, len(s))
+	for i, v := range s {
+		if std32 == 0 {
+			out[i] = PositiveZero
+			continue
+		}
+		out[i] = roundFloat32((v.ToFloat32()-mean32)/std32, FlushToZero)
+	}
+	return out, roundFloat32(mean32, FlushToZero), roundFloat32(std32, FlushToZero)
+}
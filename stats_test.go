@@ -0,0 +1,529 @@
+package float8
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// referenceStats returns the population mean and variance of s in float64.
+func referenceStats(s []Float8) (mean, variance float64) {
+	for _, v := range s {
+		mean += float64(v.ToFloat32())
+	}
+	mean /= float64(len(s))
+	for _, v := range s {
+		d := float64(v.ToFloat32()) - mean
+		variance += d * d
+	}
+	variance /= float64(len(s))
+	return mean, variance
+}
+
+func TestMeanSlice(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        []Float8
+		expected Float8
+	}{
+		{"empty", nil, PositiveZero},
+		{"single", []Float8{FromInt(3)}, FromInt(3)},
+		{"regular numbers", []Float8{One(), FromInt(2), FromInt(3), FromInt(6)}, FromInt(3)},
+		{"negative", []Float8{FromInt(-4), FromInt(-2)}, FromInt(-3)},
+		{"NaN", []Float8{One(), NaN}, NaN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := MeanSlice(tt.s)
+			if tt.expected.IsNaN() {
+				if !result.IsNaN() {
+					t.Errorf("MeanSlice(%v) = %v, want NaN", tt.s, result)
+				}
+			} else if result != tt.expected {
+				t.Errorf("MeanSlice(%v) = %v, want %v", tt.s, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestVarianceAndStdDev(t *testing.T) {
+	tests := []struct {
+		name string
+		s    []Float8
+	}{
+		{"small integers", []Float8{FromInt(2), FromInt(4), FromInt(4), FromInt(4), FromInt(5), FromInt(5), FromInt(7), FromInt(9)}},
+		{"fractions", []Float8{ToFloat8(0.5), ToFloat8(0.25), ToFloat8(-0.75), ToFloat8(1.5)}},
+		{"large offset", []Float8{FromInt(200), FromInt(208), FromInt(216), FromInt(224)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, variance := referenceStats(tt.s)
+
+			wantVar := ToFloat8(float32(variance))
+			if got := Variance(tt.s); got != wantVar {
+				t.Errorf("Variance(%v) = %v, want %v (reference %g)", tt.s, got, wantVar, variance)
+			}
+
+			wantStd := ToFloat8(float32(math.Sqrt(variance)))
+			if got := StdDev(tt.s); got != wantStd {
+				t.Errorf("StdDev(%v) = %v, want %v (reference %g)", tt.s, got, wantStd, math.Sqrt(variance))
+			}
+		})
+	}
+
+	t.Run("known values", func(t *testing.T) {
+		s := []Float8{FromInt(2), FromInt(4), FromInt(4), FromInt(4), FromInt(5), FromInt(5), FromInt(7), FromInt(9)}
+		if got := Variance(s); got != FromInt(4) {
+			t.Errorf("Variance(%v) = %v, want 4", s, got)
+		}
+		if got := StdDev(s); got != FromInt(2) {
+			t.Errorf("StdDev(%v) = %v, want 2", s, got)
+		}
+	})
+}
+
+func TestVarianceSpecialCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        []Float8
+		expected Float8
+	}{
+		{"empty", nil, PositiveZero},
+		{"single", []Float8{FromInt(5)}, PositiveZero},
+		{"constant", []Float8{FromInt(3), FromInt(3), FromInt(3)}, PositiveZero},
+		{"NaN", []Float8{One(), NaN, FromInt(2)}, NaN},
+		{"infinity", []Float8{One(), PositiveInfinity}, NaN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, fn := range []struct {
+				name string
+				f    func([]Float8) Float8
+			}{{"Variance", Variance}, {"StdDev", StdDev}} {
+				result := fn.f(tt.s)
+				if tt.expected.IsNaN() {
+					if !result.IsNaN() {
+						t.Errorf("%s(%v) = %v, want NaN", fn.name, tt.s, result)
+					}
+				} else if result != tt.expected {
+					t.Errorf("%s(%v) = %v, want %v", fn.name, tt.s, result, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestArgmaxArgmin(t *testing.T) {
+	tests := []struct {
+		name           string
+		s              []Float8
+		argmax, argmin int
+	}{
+		{"empty", nil, -1, -1},
+		{"single", []Float8{FromInt(3)}, 0, 0},
+		{"regular numbers", []Float8{FromInt(2), FromInt(-5), FromInt(7), One()}, 2, 1},
+		{"ties first index wins", []Float8{One(), FromInt(4), FromInt(-3), FromInt(4), FromInt(-3)}, 1, 2},
+		{"all equal", []Float8{FromInt(2), FromInt(2), FromInt(2)}, 0, 0},
+		{"signed zeros tie", []Float8{PositiveZero, NegativeZero}, 0, 0},
+		{"leading NaN", []Float8{NaN, FromInt(-1), FromInt(5)}, 2, 1},
+		{"infinities", []Float8{One(), NegativeInfinity, PositiveInfinity}, 2, 1},
+		{"all NaN", []Float8{NaN, 0xFF}, -1, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Argmax(tt.s); got != tt.argmax {
+				t.Errorf("Argmax(%v) = %d, want %d", tt.s, got, tt.argmax)
+			}
+			if got := Argmin(tt.s); got != tt.argmin {
+				t.Errorf("Argmin(%v) = %d, want %d", tt.s, got, tt.argmin)
+			}
+		})
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	t.Run("all ones", func(t *testing.T) {
+		counts := Histogram(Ones(10))
+		for i, c := range counts {
+			want := 0
+			if i == 0x38 {
+				want = 10
+			}
+			if c != want {
+				t.Errorf("Histogram(Ones(10))[0x%02x] = %d, want %d", i, c, want)
+			}
+		}
+	})
+
+	t.Run("counts sum to length", func(t *testing.T) {
+		s := RandSlice8(rand.New(rand.NewPCG(7, 8)), 5000)
+		counts := Histogram(s)
+		total := 0
+		for _, c := range counts {
+			total += c
+		}
+		if total != len(s) {
+			t.Errorf("Histogram counts sum to %d, want %d", total, len(s))
+		}
+	})
+}
+
+func TestHistogramByValue(t *testing.T) {
+	s := []Float8{One(), PositiveZero, NegativeZero, NaN, 0xFF, One(), FromInt(-2), NegativeZero}
+	counts := HistogramByValue(s)
+
+	expected := map[Float8]int{One(): 2, PositiveZero: 3, NaN: 2, FromInt(-2): 1}
+	if len(counts) != len(expected) {
+		t.Errorf("HistogramByValue() has %d entries, want %d: %v", len(counts), len(expected), counts)
+	}
+	total := 0
+	for v, want := range expected {
+		if counts[v] != want {
+			t.Errorf("HistogramByValue()[%v] = %d, want %d", v, counts[v], want)
+		}
+		total += counts[v]
+	}
+	if total != len(s) {
+		t.Errorf("HistogramByValue counts sum to %d, want %d", total, len(s))
+	}
+}
+
+func TestSpecialCounts(t *testing.T) {
+	s := []Float8{
+		NaN, 0xFF, NaN, // 3 NaN
+		PositiveInfinity, NegativeInfinity, // 2 Inf
+		PositiveZero, NegativeZero, PositiveZero, NegativeZero, // 4 zeros
+		SmallestPositive, 0x87, // 2 subnormals
+		One(), MaxValue, MinValue, // 3 normals
+	}
+
+	nan, inf, zero, subnormal := SpecialCounts(s)
+	if nan != 3 || inf != 2 || zero != 4 || subnormal != 2 {
+		t.Errorf("SpecialCounts() = %d, %d, %d, %d, want 3, 2, 4, 2", nan, inf, zero, subnormal)
+	}
+	if got := CountNaN(s); got != 3 {
+		t.Errorf("CountNaN() = %d, want 3", got)
+	}
+	if got := CountInf(s); got != 2 {
+		t.Errorf("CountInf() = %d, want 2", got)
+	}
+	if got := CountZero(s); got != 4 {
+		t.Errorf("CountZero() = %d, want 4", got)
+	}
+
+	nan, inf, zero, subnormal = SpecialCounts(nil)
+	if nan != 0 || inf != 0 || zero != 0 || subnormal != 0 {
+		t.Errorf("SpecialCounts(nil) = %d, %d, %d, %d, want all zero", nan, inf, zero, subnormal)
+	}
+}
+
+func TestNormalizeMinMax(t *testing.T) {
+	s := []Float8{FromInt(2), FromInt(4), FromInt(6), FromInt(10)}
+	out, scale, offset := NormalizeMinMax(s)
+
+	expected := []Float8{PositiveZero, ToFloat8(0.25), ToFloat8(0.5), One()}
+	for i := range expected {
+		if out[i] != expected[i] {
+			t.Errorf("NormalizeMinMax(%v)[%d] = %v, want %v", s, i, out[i], expected[i])
+		}
+	}
+	if scale != FromInt(8) || offset != FromInt(2) {
+		t.Errorf("NormalizeMinMax(%v) scale, offset = %v, %v, want 8, 2", s, scale, offset)
+	}
+
+	// The returned transform reproduces the mapping on random data
+	rng := rand.New(rand.NewPCG(1, 2))
+	s = make([]Float8, 200)
+	for i := range s {
+		s[i] = ToFloat8(rng.Float32()*200 - 100)
+	}
+	out, scale, offset = NormalizeMinMax(s)
+	if m := out[Argmin(out)]; m != PositiveZero {
+		t.Errorf("NormalizeMinMax() min = %v, want 0", m)
+	}
+	if m := out[Argmax(out)]; m != One() {
+		t.Errorf("NormalizeMinMax() max = %v, want 1", m)
+	}
+	for i, v := range s {
+		if !v.IsFinite() {
+			continue
+		}
+		applied := (v.ToFloat32() - offset.ToFloat32()) / scale.ToFloat32()
+		if math.Abs(float64(applied-out[i].ToFloat32())) > 0.07 {
+			t.Errorf("(%v - offset) / scale = %v, want %v", v, applied, out[i])
+		}
+	}
+}
+
+func TestNormalizeMinMaxSpecialCases(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		if out, scale, offset := NormalizeMinMax(nil); out != nil || scale != PositiveZero || offset != PositiveZero {
+			t.Errorf("NormalizeMinMax(nil) = %v, %v, %v, want nil, 0, 0", out, scale, offset)
+		}
+	})
+
+	t.Run("all equal", func(t *testing.T) {
+		out, scale, offset := NormalizeMinMax([]Float8{FromInt(3), FromInt(3)})
+		if out[0] != PositiveZero || out[1] != PositiveZero || scale != PositiveZero || offset != FromInt(3) {
+			t.Errorf("NormalizeMinMax([3 3]) = %v, %v, %v, want [0 0], 0, 3", out, scale, offset)
+		}
+	})
+
+	t.Run("no finite elements", func(t *testing.T) {
+		out, scale, offset := NormalizeMinMax([]Float8{NaN, PositiveInfinity})
+		if !out[0].IsNaN() || out[1] != PositiveInfinity || scale != PositiveZero || offset != PositiveZero {
+			t.Errorf("NormalizeMinMax([NaN +Inf]) = %v, %v, %v, want [NaN +Inf], 0, 0", out, scale, offset)
+		}
+	})
+
+	t.Run("non-finite", func(t *testing.T) {
+		s := []Float8{NaN, NegativeInfinity, FromInt(-1), One(), PositiveInfinity}
+		out, scale, offset := NormalizeMinMax(s)
+		if scale != FromInt(2) || offset != FromInt(-1) {
+			t.Errorf("NormalizeMinMax(%v) scale, offset = %v, %v, want 2, -1", s, scale, offset)
+		}
+		expected := []Float8{NaN, NegativeInfinity, PositiveZero, One(), PositiveInfinity}
+		for i := range expected {
+			if out[i] != expected[i] {
+				t.Errorf("NormalizeMinMax(%v)[%d] = %v, want %v", s, i, out[i], expected[i])
+			}
+		}
+	})
+}
+
+func TestStandardize(t *testing.T) {
+	s := []Float8{FromInt(2), FromInt(4), FromInt(4), FromInt(4), FromInt(5), FromInt(5), FromInt(7), FromInt(9)}
+	out, mean, std := Standardize(s)
+	if mean != FromInt(5) || std != FromInt(2) {
+		t.Errorf("Standardize(%v) mean, std = %v, %v, want 5, 2", s, mean, std)
+	}
+	expected := []Float8{ToFloat8(-1.5), ToFloat8(-0.5), ToFloat8(-0.5), ToFloat8(-0.5), PositiveZero, PositiveZero, One(), FromInt(2)}
+	for i := range expected {
+		if out[i] != expected[i] {
+			t.Errorf("Standardize(%v)[%d] = %v, want %v", s, i, out[i], expected[i])
+		}
+	}
+
+	// Standardized random data has mean near 0 and standard deviation near 1
+	rng := rand.New(rand.NewPCG(1, 2))
+	s = make([]Float8, 500)
+	for i := range s {
+		s[i] = ToFloat8(float32(rng.NormFloat64()*8 + 20))
+	}
+	out, _, _ = Standardize(s)
+	gotMean, gotVariance := referenceStats(out)
+	if math.Abs(gotMean) > 0.05 {
+		t.Errorf("mean of Standardize() = %v, want ≈0", gotMean)
+	}
+	if math.Abs(math.Sqrt(gotVariance)-1) > 0.05 {
+		t.Errorf("standard deviation of Standardize() = %v, want ≈1", math.Sqrt(gotVariance))
+	}
+}
+
+func TestStandardizeSpecialCases(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		if out, mean, std := Standardize(nil); out != nil || mean != PositiveZero || std != PositiveZero {
+			t.Errorf("Standardize(nil) = %v, %v, %v, want nil, 0, 0", out, mean, std)
+		}
+	})
+
+	t.Run("constant", func(t *testing.T) {
+		out, mean, std := Standardize([]Float8{FromInt(3), FromInt(3), FromInt(3)})
+		if mean != FromInt(3) || std != PositiveZero {
+			t.Errorf("Standardize([3 3 3]) mean, std = %v, %v, want 3, 0", mean, std)
+		}
+		for i, v := range out {
+			if v != PositiveZero {
+				t.Errorf("Standardize([3 3 3])[%d] = %v, want 0", i, v)
+			}
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		out, mean, std := Standardize([]Float8{})
+		if out == nil || len(out) != 0 || mean != PositiveZero || std != PositiveZero {
+			t.Errorf("Standardize([]) = %v, %v, %v, want [], 0, 0", out, mean, std)
+		}
+	})
+
+	t.Run("single", func(t *testing.T) {
+		if out, _, std := Standardize([]Float8{FromInt(-7)}); out[0] != PositiveZero || std != PositiveZero {
+			t.Errorf("Standardize([-7]) = %v, std %v, want [0], std 0", out, std)
+		}
+	})
+
+	t.Run("non-finite", func(t *testing.T) {
+		for _, bad := range []Float8{NaN, PositiveInfinity} {
+			out, _, _ := Standardize([]Float8{One(), bad, FromInt(3)})
+			for i, v := range out {
+				if !v.IsNaN() {
+					t.Errorf("Standardize([1 %v 3])[%d] = %v, want NaN", bad, i, v)
+				}
+			}
+		}
+	})
+}
+
+func TestWeightedSum(t *testing.T) {
+	values := []Float8{One(), FromInt(2), FromInt(3)}
+	tests := []struct {
+		name     string
+		weights  []Float8
+		expected Float8
+	}{
+		{"unit weights", []Float8{One(), One(), One()}, FromInt(6)},
+		{"mixed weights", []Float8{FromInt(2), ToFloat8(0.5), FromInt(-1)}, PositiveZero},
+		{"zero weights", []Float8{PositiveZero, PositiveZero, PositiveZero}, PositiveZero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WeightedSum(values, tt.weights); got != tt.expected {
+				t.Errorf("WeightedSum(%v, %v) = %v, want %v", values, tt.weights, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWeightedMean(t *testing.T) {
+	values := []Float8{One(), FromInt(2), FromInt(3), FromInt(6)}
+
+	// Uniform weights reduce to the plain mean
+	for _, w := range []Float8{One(), ToFloat8(0.25), FromInt(10)} {
+		if got, want := WeightedMean(values, Full(len(values), w)), MeanSlice(values); got != want {
+			t.Errorf("WeightedMean(%v, all %v) = %v, want MeanSlice = %v", values, w, got, want)
+		}
+	}
+
+	// A single dominant weight selects its value
+	dominant := []Float8{PositiveZero, PositiveZero, FromInt(5), PositiveZero}
+	if got := WeightedMean(values, dominant); got != FromInt(3) {
+		t.Errorf("WeightedMean(%v, %v) = %v, want 3", values, dominant, got)
+	}
+
+	if got := WeightedMean(values, []Float8{One(), One(), FromInt(2), PositiveZero}); got != ToFloat8(2.25) {
+		t.Errorf("WeightedMean(%v, [1 1 2 0]) = %v, want 2.25", values, got)
+	}
+
+	for _, weights := range [][]Float8{Zeros(len(values)), {One(), FromInt(-1), PositiveZero, PositiveZero}} {
+		if got := WeightedMean(values, weights); !got.IsNaN() {
+			t.Errorf("WeightedMean(%v, %v) = %v, want NaN", values, weights, got)
+		}
+	}
+	if got := WeightedMean(nil, nil); !got.IsNaN() {
+		t.Errorf("WeightedMean(nil, nil) = %v, want NaN", got)
+	}
+	if got := WeightedMean([]Float8{One(), NaN}, []Float8{One(), One()}); !got.IsNaN() {
+		t.Errorf("WeightedMean([1 NaN], [1 1]) = %v, want NaN", got)
+	}
+}
+
+func TestWeightedLengthMismatch(t *testing.T) {
+	for _, fn := range []func(values, weights []Float8) Float8{WeightedSum, WeightedMean} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Expected panic but function completed successfully")
+				}
+			}()
+			fn([]Float8{One()}, []Float8{One(), One()})
+		}()
+	}
+}
+
+func TestQuantile(t *testing.T) {
+	s := []Float8{FromInt(4), One(), FromInt(3), FromInt(2)}
+
+	tests := []struct {
+		name string
+		s    []Float8
+		q    float64
+		want Float8
+	}{
+		{"min", s, 0, One()},
+		{"max", s, 1, FromInt(4)},
+		{"even median", s, 0.5, ToFloat8(2.5)},
+		{"odd median", []Float8{FromInt(3), One(), FromInt(2)}, 0.5, FromInt(2)},
+		{"exact rank", s, 1.0 / 3, FromInt(2)},
+		{"interpolated", s, 0.25, ToFloat8(1.75)},
+		{"single", []Float8{FromInt(-6)}, 0.9, FromInt(-6)},
+		{"empty", nil, 0.5, PositiveZero},
+		{"infinite max", []Float8{One(), PositiveInfinity}, 1, PositiveInfinity},
+		{"interpolates to infinity", []Float8{One(), PositiveInfinity}, 0.5, PositiveInfinity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Quantile(tt.s, tt.q); got != tt.want {
+				t.Errorf("Quantile(%v, %v) = %v, want %v", tt.s, tt.q, got, tt.want)
+			}
+		})
+	}
+
+	if got := Median(s); got != ToFloat8(2.5) {
+		t.Errorf("Median(%v) = %v, want 2.5", s, got)
+	}
+	if got, want := Median([]Float8{FromInt(3), One(), FromInt(2)}), FromInt(2); got != want {
+		t.Errorf("Median([3 1 2]) = %v, want %v", got, want)
+	}
+	if s[0] != FromInt(4) {
+		t.Error("Quantile modified its input")
+	}
+}
+
+func TestQuantileSpecialCases(t *testing.T) {
+	s := []Float8{One(), FromInt(2)}
+	for _, q := range []float64{-0.1, 1.5, math.NaN()} {
+		if got := Quantile(s, q); !got.IsNaN() {
+			t.Errorf("Quantile(%v, %v) = %v, want NaN", s, q, got)
+		}
+	}
+	if got := Median([]Float8{One(), NaN, FromInt(2)}); !got.IsNaN() {
+		t.Errorf("Median with NaN = %v, want NaN", got)
+	}
+	if got := Median([]Float8{NegativeInfinity, PositiveInfinity}); !got.IsNaN() {
+		t.Errorf("Median([-Inf +Inf]) = %v, want NaN", got)
+	}
+	if got := Median([]Float8{NegativeInfinity, One()}); got != NegativeInfinity {
+		t.Errorf("Median([-Inf 1]) = %v, want -Inf", got)
+	}
+	if got := Quantile([]Float8{NegativeInfinity, One(), FromInt(2)}, 0.25); got != NegativeInfinity {
+		t.Errorf("Quantile([-Inf 1 2], 0.25) = %v, want -Inf", got)
+	}
+	if got := Median([]Float8{One(), PositiveInfinity}); got != PositiveInfinity {
+		t.Errorf("Median([1 +Inf]) = %v, want +Inf", got)
+	}
+	if got := Quantile([]Float8{One(), FromInt(2), PositiveInfinity}, 0.75); got != PositiveInfinity {
+		t.Errorf("Quantile([1 2 +Inf], 0.75) = %v, want +Inf", got)
+	}
+}
+
+func TestMode(t *testing.T) {
+	tests := []struct {
+		name      string
+		s         []Float8
+		wantValue Float8
+		wantCount int
+	}{
+		{"clear majority", []Float8{One(), FromInt(3), FromInt(3), FromInt(-2), FromInt(3)}, FromInt(3), 3},
+		{"tie picks smaller", []Float8{FromInt(2), FromInt(-1), FromInt(2), FromInt(-1), One()}, FromInt(-1), 2},
+		{"tie with infinity", []Float8{PositiveInfinity, NegativeInfinity}, NegativeInfinity, 1},
+		{"signed zeros merge", []Float8{NegativeZero, PositiveZero, One(), One()}, PositiveZero, 2},
+		{"NaN ignored", []Float8{NaN, NaN, NegativeNaN, FromInt(5)}, FromInt(5), 1},
+		{"all NaN", []Float8{NaN}, NaN, 0},
+		{"empty", nil, NaN, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, count := Mode(tt.s)
+			if value != tt.wantValue || count != tt.wantCount {
+				t.Errorf("Mode(%v) = (%v, %d), want (%v, %d)", tt.s, value, count, tt.wantValue, tt.wantCount)
+			}
+		})
+	}
+}
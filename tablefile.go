@@ -0,0 +1,84 @@
+package float8
+
+import (
+	"os"
+)
+
+// Arithmetic table files
+//
+// A table file holds the four 64 KiB arithmetic tables so that a program can
+// load them instead of computing them in EnableFastArithmetic. The file starts
+// with a header of the magic bytes "FP8T", the package's major version, and
+// the FlushToZero setting the tables were built with (0 or 1), followed by the
+// add, sub, mul, and div tables in that order.
+const (
+	tableFileMagic      = "FP8T"
+	tableFileHeaderSize = len(tableFileMagic) + 2
+	tableFileSize       = tableFileHeaderSize + 4*65536
+)
+
+// SaveArithmeticTables writes the arithmetic lookup tables to the file at
+// path, creating or truncating it. The tables currently enabled are saved;
+// if fast arithmetic is disabled, they are computed for the current
+// FlushToZero setting without enabling them.
+func SaveArithmeticTables(path string) error {
+	// Read the setting and the tables together, so the header always
+	// describes the tables that follow it
+	tablesMu.Lock()
+	flush, tables := FlushToZero, arithTables.Load()
+	tablesMu.Unlock()
+	if tables == nil {
+		tables = buildArithmeticTables(flush)
+	}
+
+	data := make([]byte, 0, tableFileSize)
+	data = append(data, tableFileMagic...)
+	data = append(data, VersionMajor, boolByte(flush))
+	for _, table := range []*[65536]Float8{&tables.add, &tables.sub, &tables.mul, &tables.div} {
+		data = append(data, SliceAsBytes(table[:])...)
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// LoadArithmeticTables reads arithmetic lookup tables written by
+// SaveArithmeticTables from the file at path and enables them, as
+// EnableFastArithmetic would but without computing them.
+//
+// It returns an error, leaving the current tables in place, if the file
+// cannot be read, is not a table file of this major version, or was built
+// with a FlushToZero setting other than the current one. It is safe to call
+// concurrently with arithmetic on other goroutines.
+func LoadArithmeticTables(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if len(data) != tableFileSize || string(data[:len(tableFileMagic)]) != tableFileMagic {
+		return &Float8Error{Op: "load", Msg: "invalid table file"}
+	}
+	if version := data[len(tableFileMagic)]; version != VersionMajor {
+		return &Float8Error{Op: "load", Value: float32(version), Msg: "unsupported table file version"}
+	}
+
+	tablesMu.Lock()
+	defer tablesMu.Unlock()
+	if data[len(tableFileMagic)+1] != boolByte(FlushToZero) {
+		return &Float8Error{Op: "load", Msg: "table file built with a different FlushToZero setting"}
+	}
+
+	tables := new(arithmeticTables)
+	body := data[tableFileHeaderSize:]
+	for i, table := range []*[65536]Float8{&tables.add, &tables.sub, &tables.mul, &tables.div} {
+		copy(SliceAsBytes(table[:]), body[i*65536:])
+	}
+	arithTables.Store(tables)
+	return nil
+}
+
+// boolByte returns 1 for true and 0 for false
+func boolByte(b bool) byte {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,112 @@
+package float8
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSaveLoadArithmeticTables(t *testing.T) {
+	DisableFastArithmetic()
+	defer DisableFastArithmetic()
+
+	path := filepath.Join(t.TempDir(), "tables.fp8t")
+	if err := SaveArithmeticTables(path); err != nil {
+		t.Fatalf("SaveArithmeticTables() error = %v", err)
+	}
+	if arithTables.Load() != nil {
+		t.Fatal("SaveArithmeticTables enabled the tables")
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Size() != int64(tableFileSize) {
+		t.Errorf("table file size = %d, want %d", info.Size(), tableFileSize)
+	}
+
+	if err := LoadArithmeticTables(path); err != nil {
+		t.Fatalf("LoadArithmeticTables() error = %v", err)
+	}
+	if arithTables.Load() == nil {
+		t.Fatal("Expected tables to be enabled after LoadArithmeticTables")
+	}
+	for a := 0; a < 256; a++ {
+		for b := 0; b < 256; b++ {
+			fa, fb := Float8(a), Float8(b)
+			for _, op := range []struct {
+				name      string
+				got, want Float8
+			}{
+				{"Add", Add(fa, fb), addAlgorithmic(fa, fb, FlushToZero)},
+				{"Sub", Sub(fa, fb), subAlgorithmic(fa, fb, FlushToZero)},
+				{"Mul", Mul(fa, fb), mulAlgorithmic(fa, fb, FlushToZero)},
+				{"Div", Div(fa, fb), divAlgorithmic(fa, fb, FlushToZero)},
+			} {
+				if op.got != op.want {
+					t.Fatalf("%s(0x%02X, 0x%02X) = 0x%02X after loading, want 0x%02X", op.name, a, b, uint8(op.got), uint8(op.want))
+				}
+			}
+		}
+	}
+
+	// Saving while the tables are enabled writes the same file
+	again := filepath.Join(t.TempDir(), "again.fp8t")
+	if err := SaveArithmeticTables(again); err != nil {
+		t.Fatalf("SaveArithmeticTables() error = %v", err)
+	}
+	want, _ := os.ReadFile(path)
+	got, _ := os.ReadFile(again)
+	if string(got) != string(want) {
+		t.Error("saving loaded tables produced a different file")
+	}
+}
+
+func TestLoadArithmeticTablesErrors(t *testing.T) {
+	DisableFastArithmetic()
+	defer DisableFastArithmetic()
+
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "valid.fp8t")
+	if err := SaveArithmeticTables(valid); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(valid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	corrupt := func(edit func(b []byte) []byte) []byte {
+		return edit(append([]byte(nil), data...))
+	}
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"truncated", data[:len(data)-1]},
+		{"bad magic", corrupt(func(b []byte) []byte { b[0] = 'X'; return b })},
+		{"bad version", corrupt(func(b []byte) []byte { b[len(tableFileMagic)]++; return b })},
+		{"flush mismatch", corrupt(func(b []byte) []byte { b[len(tableFileMagic)+1] ^= 1; return b })},
+		{"empty", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, tt.name)
+			if err := os.WriteFile(path, tt.data, 0o644); err != nil {
+				t.Fatal(err)
+			}
+			err := LoadArithmeticTables(path)
+			if _, ok := err.(*Float8Error); !ok {
+				t.Errorf("LoadArithmeticTables() error = %v, want a *Float8Error", err)
+			}
+			if arithTables.Load() != nil {
+				t.Error("a failed load enabled the tables")
+			}
+		})
+	}
+
+	if err := LoadArithmeticTables(filepath.Join(dir, "missing")); !os.IsNotExist(err) {
+		t.Errorf("LoadArithmeticTables(missing) error = %v, want not-exist", err)
+	}
+}
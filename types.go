@@ -2,6 +2,9 @@ package float8
 
 import (
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 )
 
 // Float8 represents an 8-bit floating-point number using the IEEE 754 FP8 E4M3FN format.
@@ -42,10 +45,11 @@ const (
 	NegativeZero     Float8 = 0x80
 	PositiveInfinity Float8 = 0x78 // IEEE 754 E4M3FN: S.1111.000 = 0.1111.000₂
 	NegativeInfinity Float8 = 0xF8 // IEEE 754 E4M3FN: S.1111.000 = 1.1111.000₂
-	NaN              Float8 = 0x7F // IEEE 754 E4M3FN: S.1111.111 (0x7F or 0xFF)
+	NaN              Float8 = 0x7F // IEEE 754 E4M3FN: 0.1111.111, the canonical NaN
+	NegativeNaN      Float8 = 0xFF // IEEE 754 E4M3FN: 1.1111.111, NaN with the sign bit set
 	MaxValue         Float8 = 0x7E // Largest finite positive value
 	MinValue         Float8 = 0xFE // Largest finite negative value
-	SmallestPositive Float8 = 0x01 // Smallest positive normalized value
+	SmallestPositive Float8 = 0x01 // Smallest positive subnormal value, 2^-9
 )
 
 // ConversionMode defines how conversions handle edge cases
@@ -72,6 +76,40 @@ const (
 	ArithmeticLookup
 )
 
+// FloatClass identifies the kind of value a Float8 bit pattern encodes
+type FloatClass int
+
+const (
+	// ClassZero is positive or negative zero
+	ClassZero FloatClass = iota
+	// ClassSubnormal has a zero exponent field and a nonzero mantissa
+	ClassSubnormal
+	// ClassNormal is a finite value with an implicit leading 1 bit
+	ClassNormal
+	// ClassInfinity is positive or negative infinity
+	ClassInfinity
+	// ClassNaN is either NaN encoding
+	ClassNaN
+)
+
+// String returns the name of the class
+func (c FloatClass) String() string {
+	switch c {
+	case ClassZero:
+		return "Zero"
+	case ClassSubnormal:
+		return "Subnormal"
+	case ClassNormal:
+		return "Normal"
+	case ClassInfinity:
+		return "Infinity"
+	case ClassNaN:
+		return "NaN"
+	default:
+		return fmt.Sprintf("FloatClass(%d)", int(c))
+	}
+}
+
 // Float8Error represents errors that can occur during Float8 operations
 type Float8Error struct {
 	Op    string  // Operation that caused the error
@@ -86,6 +124,45 @@ func (e *Float8Error) Error() string {
 	return fmt.Sprintf("float8.%s: %s", e.Op, e.Msg)
 }
 
+// ConversionError records a failed element of a batch conversion
+type ConversionError struct {
+	Index int          // Position of the element in the input slice
+	Err   *Float8Error // Reason the element could not be converted
+}
+
+func (e ConversionError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+// ConversionErrors aggregates every failed element of a batch conversion, in index order
+type ConversionErrors []ConversionError
+
+func (e ConversionErrors) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "float8.convert: %d element(s) failed", len(e))
+	for i, ce := range e {
+		if i == 0 {
+			b.WriteString(": ")
+		} else {
+			b.WriteString("; ")
+		}
+		fmt.Fprintf(&b, "index %d: %s", ce.Index, ce.Err.Msg)
+		if ce.Err.Value != 0 {
+			fmt.Fprintf(&b, " (value: %g)", ce.Err.Value)
+		}
+	}
+	return b.String()
+}
+
+// Unwrap returns the individual element errors for use with errors.Is and errors.As
+func (e ConversionErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, ce := range e {
+		errs[i] = ce.Err
+	}
+	return errs
+}
+
 // Common error instances
 var (
 	ErrOverflow  = &Float8Error{Op: "convert", Msg: "value too large for float8"}
@@ -119,17 +196,27 @@ func (f Float8) IsInf() bool {
 
 // IsFinite reports whether f is a finite value (not infinite and not NaN).
 //
-// A Float8 value is finite if its exponent is not all 1s (0x0F). This includes
-// both normal numbers (with an implicit leading 1 bit) and subnormal numbers
-// (with an implicit leading 0 bit).
+// A Float8 value is finite unless it is one of the infinity encodings (0x78, 0xF8)
+// or a NaN encoding (0x7F, 0xFF). This includes zeros, subnormal numbers (with an
+// implicit leading 0 bit), and normal numbers (with an implicit leading 1 bit),
+// including the values with an all-1s exponent such as MaxValue.
 //
 // Returns:
 //   - true if f is a finite number (including zero and subnormals)
 //   - false if f is infinity or NaN
 func (f Float8) IsFinite() bool {
-	// Extract exponent bits (bits 3-6)
-	exp := (f & ExponentMask) >> MantissaLen
-	return exp < 0x0F // Finite if exponent is not all 1s (0x0F = 1111)
+	return !f.IsInf() && !f.IsNaN()
+}
+
+// IsSubnormal reports whether f is a subnormal value.
+//
+// Subnormals have an all-zero exponent field and a nonzero mantissa, so they have
+// no implicit leading 1 bit. These are the bit patterns 0x01-0x07 and 0x81-0x87.
+//
+// Together, IsZero, IsSubnormal, IsNormal, IsInf, and IsNaN partition all 256
+// Float8 values: exactly one of them is true for any f.
+func (f Float8) IsSubnormal() bool {
+	return f&ExponentMask == 0 && f&MantissaMask != 0
 }
 
 // IsNaN reports whether f is a "not-a-number" (NaN) value.
@@ -147,6 +234,59 @@ func (f Float8) IsNaN() bool {
 	return (f&0x7F == 0x7F) && (f&0x07 == 0x07)
 }
 
+// IsInteger reports whether f is a finite value with no fractional part,
+// including ±0.
+//
+// Every finite value with magnitude 8 or more is an integer: at exponent 2^3
+// the three mantissa bits step by exactly 1, and larger exponents step by 2,
+// 4, and so on, so the format cannot represent fractions there. Subnormals
+// are all below 1 and are never integers.
+func (f Float8) IsInteger() bool {
+	if f.IsZero() {
+		return true
+	}
+	if !f.IsFinite() || f.IsSubnormal() {
+		return false
+	}
+	exp := int(f&ExponentMask)>>MantissaLen - ExponentBias
+	if exp < 0 {
+		return false
+	}
+	if exp >= MantissaLen {
+		return true
+	}
+	// The low MantissaLen-exp mantissa bits hold the fractional part
+	return f&MantissaMask&(1<<(MantissaLen-exp)-1) == 0
+}
+
+// Classify reports which class of value f encodes.
+//
+// The class is decoded directly from the exponent and mantissa fields:
+//   - exponent 0000, mantissa 000: ClassZero
+//   - exponent 0000, mantissa nonzero: ClassSubnormal
+//   - exponent 1111, mantissa 000: ClassInfinity
+//   - exponent 1111, mantissa 111: ClassNaN
+//   - anything else: ClassNormal
+//
+// The sign bit does not affect the class.
+func (f Float8) Classify() FloatClass {
+	exp := (f & ExponentMask) >> MantissaLen
+	mant := f & MantissaMask
+
+	switch {
+	case exp == 0 && mant == 0:
+		return ClassZero
+	case exp == 0:
+		return ClassSubnormal
+	case exp == 0x0F && mant == 0:
+		return ClassInfinity
+	case exp == 0x0F && mant == MantissaMask:
+		return ClassNaN
+	default:
+		return ClassNormal
+	}
+}
+
 // Sign returns the sign of the Float8 value.
 //
 // The return values are:
@@ -172,12 +312,24 @@ func (f Float8) Sign() int {
 	return 1
 }
 
+// SignBit reports whether the sign bit of f is set.
+//
+// Unlike Sign, SignBit distinguishes negative zero from positive zero and
+// reports the sign of infinities and NaN encodings, mirroring math.Signbit:
+//
+//	SignBit(-0) = true
+//	SignBit(+0) = false
+//	SignBit(0xFF) = true (negative NaN encoding)
+func (f Float8) SignBit() bool {
+	return f&SignMask != 0
+}
+
 // Abs returns the absolute value of f.
 //
 // Special cases are:
 //
 //	Abs(±Inf) = +Inf
-//	Abs(NaN) = NaN
+//	Abs(±NaN) = NaN
 //	Abs(±0) = +0
 //
 // For all other values, Abs clears the sign bit to return a positive number.
@@ -185,7 +337,8 @@ func (f Float8) Abs() Float8 {
 	return f &^ SignMask // Clear sign bit
 }
 
-// Neg returns the negation of the Float8
+// Neg returns the negation of the Float8. Zeros keep their sign, and the two
+// NaN encodings swap: Neg(NaN) = NegativeNaN and Neg(NegativeNaN) = NaN.
 func (f Float8) Neg() Float8 {
 	if f.IsZero() {
 		return f // Preserve zero sign for IEEE compliance
@@ -193,16 +346,139 @@ func (f Float8) Neg() Float8 {
 	return f ^ SignMask // Flip sign bit
 }
 
+// ClampToFinite returns MaxValue for +Inf and MinValue for -Inf, undoing
+// overflow to infinity. NaN and finite values, including both zeros, are
+// returned unchanged.
+func (f Float8) ClampToFinite() Float8 {
+	if f.IsInf() {
+		return f&SignMask | MaxValue
+	}
+	return f
+}
+
 // String returns a string representation of the Float8 value
 func (f Float8) String() string {
 	return fmt.Sprintf("%.6g", f.ToFloat32())
 }
 
+// Format implements fmt.Formatter.
+//
+// The verbs are:
+//
+//	%v, %s      the decimal value as printed by String; %#v is GoString
+//	%e, %f, %g  the decimal value, as for float32 (also %E, %F, %G)
+//	%x, %X      the bit pattern as two hexadecimal digits
+//	%b          the bit pattern as eight binary digits (sign, exponent, mantissa)
+//	%d, %o      the bit pattern as an integer
+//	%q          the String value, quoted
+//
+// Width, precision, and flags apply as for the corresponding float32 or
+// uint8 verb. Without an explicit width, %x and %b are zero-padded to the
+// full bit pattern; with '#' they gain a 0x, 0X, or 0b prefix.
+func (f Float8) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v', 's':
+		if verb == 'v' && s.Flag('#') {
+			fmt.Fprint(s, f.GoString())
+			return
+		}
+		format := fmt.FormatString(s, 'g')
+		if _, ok := s.Precision(); !ok {
+			// Match String, which prints six significant digits
+			format = format[:len(format)-1] + ".6g"
+		}
+		fmt.Fprintf(s, format, f.ToFloat32())
+	case 'e', 'E', 'f', 'F', 'g', 'G':
+		fmt.Fprintf(s, fmt.FormatString(s, verb), f.ToFloat32())
+	case 'x', 'X', 'b':
+		if _, ok := s.Width(); ok {
+			fmt.Fprintf(s, fmt.FormatString(s, verb), uint8(f))
+			return
+		}
+		digits, prefix := 2, "0x"
+		switch verb {
+		case 'X':
+			prefix = "0X"
+		case 'b':
+			digits, prefix = 8, "0b"
+		}
+		if !s.Flag('#') {
+			prefix = ""
+		}
+		fmt.Fprintf(s, "%s%0*"+string(verb), prefix, digits, uint8(f))
+	case 'd', 'o':
+		fmt.Fprintf(s, fmt.FormatString(s, verb), uint8(f))
+	case 'q':
+		fmt.Fprintf(s, fmt.FormatString(s, verb), f.String())
+	default:
+		fmt.Fprintf(s, "%%!%c(float8.Float8=%s)", verb, f.String())
+	}
+}
+
 // GoString returns a Go syntax representation of the Float8 value
 func (f Float8) GoString() string {
 	return fmt.Sprintf("float8.FromBits(0x%02x)", uint8(f))
 }
 
+// Hex returns the bit pattern of f as a two-digit hexadecimal string such as "0x38".
+func (f Float8) Hex() string {
+	return fmt.Sprintf("0x%02x", uint8(f))
+}
+
+// ParseHex parses a bit pattern written in hexadecimal, with or without a
+// "0x" or "0X" prefix, such as "0x38", "0X38", or "38". It is the inverse of Hex.
+func ParseHex(s string) (Float8, error) {
+	digits := strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	if digits == "" || len(digits) > 2 {
+		return 0, &Float8Error{Op: "parsehex", Msg: fmt.Sprintf("invalid bit pattern %q", s)}
+	}
+	bits, err := strconv.ParseUint(digits, 16, 8)
+	if err != nil {
+		return 0, &Float8Error{Op: "parsehex", Msg: fmt.Sprintf("invalid bit pattern %q", s)}
+	}
+	return Float8(bits), nil
+}
+
+// Decompose returns the raw sign, exponent, and mantissa bit fields of f.
+// The exponent is the biased 4-bit field and the mantissa excludes the
+// implicit leading bit.
+func (f Float8) Decompose() (sign, exponent, mantissa uint8) {
+	return uint8(f >> 7), uint8((f & ExponentMask) >> MantissaLen), uint8(f & MantissaMask)
+}
+
+// DebugString returns f in hexadecimal floating-point notation followed by
+// its bit fields, for example "-0x1.4p+1 (sign=1 exp=1000 mant=010)".
+//
+// Normal values are shown with their implicit leading 1 and subnormals and
+// zeros with a leading 0 and the minimum exponent, so the notation reads as
+// the exact value. Infinities and NaN are shown by name.
+func (f Float8) DebugString() string {
+	sign, exp, mant := f.Decompose()
+	fields := fmt.Sprintf("(sign=%d exp=%04b mant=%03b)", sign, exp, mant)
+
+	var value string
+	switch {
+	case f.IsNaN():
+		value = "NaN"
+	case f.IsInf():
+		value = "+Inf"
+		if sign != 0 {
+			value = "-Inf"
+		}
+	default:
+		lead, unbiased := 1, int(exp)-ExponentBias
+		if exp == 0 {
+			lead, unbiased = 0, 1-ExponentBias
+		}
+		// Three mantissa bits fill the top of one hex digit
+		value = fmt.Sprintf("0x%d.%xp%+d", lead, mant<<1, unbiased)
+		if sign != 0 {
+			value = "-" + value
+		}
+	}
+	return value + " " + fields
+}
+
 // Bits returns the underlying uint8 representation
 func (f Float8) Bits() uint8 {
 	return uint8(f)
@@ -212,3 +488,70 @@ func (f Float8) Bits() uint8 {
 func FromBits(bits uint8) Float8 {
 	return Float8(bits)
 }
+
+// AllValues returns every Float8 bit pattern, 0x00 through 0xFF, in encoding order.
+func AllValues() []Float8 {
+	values := make([]Float8, 256)
+	for i := range values {
+		values[i] = Float8(i)
+	}
+	return values
+}
+
+// AllFiniteValues returns every finite Float8 value sorted ascending by real
+// value, from MinValue to MaxValue. Both zeros are included, with -0 before +0.
+func AllFiniteValues() []Float8 {
+	var positive []Float8
+	for b := PositiveZero; b < SignMask; b++ {
+		if b.IsFinite() {
+			positive = append(positive, b)
+		}
+	}
+
+	// Positive magnitudes increase with their encoding, so the negative half
+	// is the same sequence reversed with the sign bit set.
+	values := make([]Float8, 0, 2*len(positive))
+	for i := len(positive) - 1; i >= 0; i-- {
+		values = append(values, positive[i]|SignMask)
+	}
+	return append(values, positive...)
+}
+
+// IntegerValues returns every Float8 value for which IsInteger is true,
+// sorted ascending by real value. Both zeros are included, with -0 before +0.
+func IntegerValues() []Float8 {
+	var values []Float8
+	for _, f := range AllFiniteValues() {
+		if f.IsInteger() {
+			values = append(values, f)
+		}
+	}
+	return values
+}
+
+// CountRepresentable returns the number of distinct finite Float8 values in
+// the closed interval [lo, hi]. The two zeros count as one value, and the
+// infinities are never counted, even if the interval includes them.
+//
+// Reversed bounds are swapped, and bounds beyond the Float8 range simply
+// include every value on that side. CountRepresentable returns 0 if either
+// bound is NaN.
+func CountRepresentable(lo, hi float32) int {
+	if math.IsNaN(float64(lo)) || math.IsNaN(float64(hi)) {
+		return 0
+	}
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+
+	var count int
+	for _, f := range AllFiniteValues() {
+		if f == NegativeZero {
+			continue
+		}
+		if v := f.ToFloat32(); v >= lo && v <= hi {
+			count++
+		}
+	}
+	return count
+}
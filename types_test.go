@@ -1,7 +1,9 @@
 package float8
 
 import (
+	"fmt"
 	"math"
+	"strconv"
 	"testing"
 )
 
@@ -122,3 +124,460 @@ func TestNeg(t *testing.T) {
 		})
 	}
 }
+
+func TestNaNEncodings(t *testing.T) {
+	for _, f := range []Float8{NaN, NegativeNaN} {
+		if !f.IsNaN() {
+			t.Errorf("0x%02x.IsNaN() = false, want true", uint8(f))
+		}
+		if Equal(f, f) {
+			t.Errorf("Equal(0x%02x, 0x%02x) = true, want false", uint8(f), uint8(f))
+		}
+		if got := f.Abs(); got != NaN {
+			t.Errorf("0x%02x.Abs() = 0x%02x, want 0x%02x", uint8(f), uint8(got), uint8(NaN))
+		}
+	}
+	if Equal(NaN, NegativeNaN) {
+		t.Error("Equal(NaN, NegativeNaN) = true, want false")
+	}
+
+	if got := NaN.Neg(); got != NegativeNaN {
+		t.Errorf("NaN.Neg() = 0x%02x, want 0x%02x", uint8(got), uint8(NegativeNaN))
+	}
+	if got := NegativeNaN.Neg(); got != NaN {
+		t.Errorf("NegativeNaN.Neg() = 0x%02x, want 0x%02x", uint8(got), uint8(NaN))
+	}
+	if !NaN.Neg().SignBit() || NegativeNaN.Abs().SignBit() {
+		t.Error("Neg/Abs do not set and clear the NaN sign bit")
+	}
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name string
+		f    Float8
+		want FloatClass
+	}{
+		{"positive zero", PositiveZero, ClassZero},
+		{"negative zero", NegativeZero, ClassZero},
+		{"smallest subnormal", 0x01, ClassSubnormal},
+		{"largest subnormal", 0x07, ClassSubnormal},
+		{"negative subnormal", 0x83, ClassSubnormal},
+		{"smallest normal", 0x08, ClassNormal},
+		{"one", FromInt(1), ClassNormal},
+		{"negative one", FromInt(-1), ClassNormal},
+		{"max value", MaxValue, ClassNormal},
+		{"min value", MinValue, ClassNormal},
+		{"exponent 1111 finite", 0x79, ClassNormal},
+		{"infinity", PositiveInfinity, ClassInfinity},
+		{"negative infinity", NegativeInfinity, ClassInfinity},
+		{"NaN", NaN, ClassNaN},
+		{"negative NaN", Float8(0xFF), ClassNaN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.Classify(); got != tt.want {
+				t.Errorf("Float8(0x%02x).Classify() = %v, want %v", uint8(tt.f), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFloatClassString(t *testing.T) {
+	tests := []struct {
+		c    FloatClass
+		want string
+	}{
+		{ClassZero, "Zero"},
+		{ClassSubnormal, "Subnormal"},
+		{ClassNormal, "Normal"},
+		{ClassInfinity, "Infinity"},
+		{ClassNaN, "NaN"},
+		{FloatClass(42), "FloatClass(42)"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.c.String(); got != tt.want {
+			t.Errorf("FloatClass(%d).String() = %q, want %q", int(tt.c), got, tt.want)
+		}
+	}
+}
+
+func TestIsSubnormal(t *testing.T) {
+	for i := 0; i < 256; i++ {
+		f := Float8(i)
+		want := (i >= 0x01 && i <= 0x07) || (i >= 0x81 && i <= 0x87)
+		if got := f.IsSubnormal(); got != want {
+			t.Errorf("Float8(0x%02x).IsSubnormal() = %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestClassificationPartition(t *testing.T) {
+	for i := 0; i < 256; i++ {
+		f := Float8(i)
+		predicates := []struct {
+			name  string
+			value bool
+			class FloatClass
+		}{
+			{"IsZero", f.IsZero(), ClassZero},
+			{"IsSubnormal", f.IsSubnormal(), ClassSubnormal},
+			{"IsNormal", f.IsNormal(), ClassNormal},
+			{"IsInf", f.IsInf(), ClassInfinity},
+			{"IsNaN", f.IsNaN(), ClassNaN},
+		}
+
+		matches := 0
+		for _, p := range predicates {
+			if p.value {
+				matches++
+				if f.Classify() != p.class {
+					t.Errorf("Float8(0x%02x).%s() = true but Classify() = %v", i, p.name, f.Classify())
+				}
+			}
+		}
+		if matches != 1 {
+			t.Errorf("Float8(0x%02x) satisfies %d classification predicates, want exactly 1", i, matches)
+		}
+
+		if f.IsFinite() != (!f.IsInf() && !f.IsNaN()) {
+			t.Errorf("Float8(0x%02x).IsFinite() = %v, inconsistent with IsInf/IsNaN", i, f.IsFinite())
+		}
+	}
+}
+
+func TestSignBit(t *testing.T) {
+	tests := []struct {
+		name string
+		f    Float8
+		want bool
+	}{
+		{"positive zero", PositiveZero, false},
+		{"negative zero", NegativeZero, true},
+		{"infinity", PositiveInfinity, false},
+		{"negative infinity", NegativeInfinity, true},
+		{"NaN", NaN, false},
+		{"negative NaN", Float8(0xFF), true},
+		{"one", FromInt(1), false},
+		{"negative one", FromInt(-1), true},
+		{"negative subnormal", Float8(0x81), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.SignBit(); got != tt.want {
+				t.Errorf("Float8(0x%02x).SignBit() = %v, want %v", uint8(tt.f), got, tt.want)
+			}
+			if got, want := tt.f.SignBit(), math.Signbit(float64(tt.f.ToFloat32())); !tt.f.IsNaN() && got != want {
+				t.Errorf("Float8(0x%02x).SignBit() = %v, math.Signbit = %v", uint8(tt.f), got, want)
+			}
+		})
+	}
+}
+
+func TestAllValues(t *testing.T) {
+	values := AllValues()
+	if len(values) != 256 {
+		t.Fatalf("len(AllValues()) = %d, want 256", len(values))
+	}
+	for i, v := range values {
+		if v != Float8(i) {
+			t.Errorf("AllValues()[%d] = 0x%02x, want 0x%02x", i, uint8(v), i)
+		}
+	}
+}
+
+func TestAllFiniteValues(t *testing.T) {
+	values := AllFiniteValues()
+
+	// 125 nonzero finite magnitudes per sign, plus both zeros
+	if len(values) != 252 {
+		t.Errorf("len(AllFiniteValues()) = %d, want 252", len(values))
+	}
+	if values[0] != MinValue || values[len(values)-1] != MaxValue {
+		t.Errorf("AllFiniteValues() spans %v to %v, want %v to %v",
+			values[0], values[len(values)-1], MinValue, MaxValue)
+	}
+
+	seen := make(map[Float8]bool)
+	for i, v := range values {
+		if !v.IsFinite() {
+			t.Errorf("AllFiniteValues()[%d] = 0x%02x is not finite", i, uint8(v))
+		}
+		if seen[v] {
+			t.Errorf("AllFiniteValues() contains 0x%02x twice", uint8(v))
+		}
+		seen[v] = true
+		if i > 0 && !Less(values[i-1], v) && !(values[i-1] == NegativeZero && v == PositiveZero) {
+			t.Errorf("AllFiniteValues() not sorted: %v at %d followed by %v", values[i-1], i-1, v)
+		}
+	}
+
+	for _, v := range []Float8{PositiveInfinity, NegativeInfinity, NaN, 0xFF} {
+		if seen[v] {
+			t.Errorf("AllFiniteValues() contains non-finite pattern 0x%02x", uint8(v))
+		}
+	}
+}
+
+func TestHex(t *testing.T) {
+	tests := []struct {
+		input    Float8
+		expected string
+	}{
+		{PositiveZero, "0x00"},
+		{One(), "0x38"},
+		{NegativeZero, "0x80"},
+		{NaN, "0x7f"},
+		{0xFF, "0xff"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.input.Hex(); got != tt.expected {
+			t.Errorf("Float8(0x%02x).Hex() = %q, want %q", uint8(tt.input), got, tt.expected)
+		}
+	}
+}
+
+func TestParseHex(t *testing.T) {
+	for _, f := range AllValues() {
+		got, err := ParseHex(f.Hex())
+		if err != nil || got != f {
+			t.Errorf("ParseHex(%q) = 0x%02x, %v, want 0x%02x", f.Hex(), uint8(got), err, uint8(f))
+		}
+	}
+
+	tests := []struct {
+		input    string
+		expected Float8
+		wantErr  bool
+	}{
+		{"0x38", One(), false},
+		{"0X38", One(), false},
+		{"38", One(), false},
+		{"0xFE", MinValue, false},
+		{"7", 0x07, false},
+		{"0xZZ", 0, true},
+		{"0x", 0, true},
+		{"", 0, true},
+		{"0x100", 0, true},
+		{"-1", 0, true},
+		{"0x0x38", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseHex(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseHex(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.expected {
+				t.Errorf("ParseHex(%q) = 0x%02x, want 0x%02x", tt.input, uint8(got), uint8(tt.expected))
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		format   string
+		input    Float8
+		expected string
+	}{
+		{"%v", ToFloat8(1.5), "1.5"},
+		{"%v", SmallestPositive, "0.00195312"},
+		{"%s", ToFloat8(-2.5), "-2.5"},
+		{"%6v|", ToFloat8(1.5), "   1.5|"},
+		{"%-6v|", ToFloat8(1.5), "1.5   |"},
+		{"%#v", One(), "float8.FromBits(0x38)"},
+		{"%g", ToFloat8(0.3125), "0.3125"},
+		{"%.2g", ToFloat8(0.3125), "0.31"},
+		{"%.2g", FromInt(448), "4.5e+02"},
+		{"%8.3f", ToFloat8(-1.75), "  -1.750"},
+		{"%e", One(), "1.000000e+00"},
+		{"%x", One(), "38"},
+		{"%x", SmallestPositive, "01"},
+		{"%X", NaN, "7F"},
+		{"%#x", One(), "0x38"},
+		{"%4x", One(), "  38"},
+		{"%b", One(), "00111000"},
+		{"%08b", SmallestPositive, "00000001"},
+		{"%08b", NegativeZero, "10000000"},
+		{"%d", One(), "56"},
+		{"%q", ToFloat8(1.5), `"1.5"`},
+		{"%v", PositiveInfinity, "+Inf"},
+		{"%g", NaN, "NaN"},
+		{"%z", One(), "%!z(float8.Float8=1)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.input); got != tt.expected {
+				t.Errorf("Sprintf(%q, 0x%02x) = %q, want %q", tt.format, uint8(tt.input), got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDecompose(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          Float8
+		sign, exp, mnt uint8
+		debug          string
+	}{
+		{"One", One(), 0, 7, 0, "0x1.0p+0 (sign=0 exp=0111 mant=000)"},
+		{"MinusTwoPointFive", ToFloat8(-2.5), 1, 8, 2, "-0x1.4p+1 (sign=1 exp=1000 mant=010)"},
+		{"PositiveZero", PositiveZero, 0, 0, 0, "0x0.0p-6 (sign=0 exp=0000 mant=000)"},
+		{"NegativeZero", NegativeZero, 1, 0, 0, "-0x0.0p-6 (sign=1 exp=0000 mant=000)"},
+		{"Subnormal", 0x03, 0, 0, 3, "0x0.6p-6 (sign=0 exp=0000 mant=011)"},
+		{"MaxValue", MaxValue, 0, 15, 6, "0x1.cp+8 (sign=0 exp=1111 mant=110)"},
+		{"PositiveInfinity", PositiveInfinity, 0, 15, 0, "+Inf (sign=0 exp=1111 mant=000)"},
+		{"NegativeInfinity", NegativeInfinity, 1, 15, 0, "-Inf (sign=1 exp=1111 mant=000)"},
+		{"NaN", NaN, 0, 15, 7, "NaN (sign=0 exp=1111 mant=111)"},
+		{"NegativeNaN", 0xFF, 1, 15, 7, "NaN (sign=1 exp=1111 mant=111)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sign, exp, mant := tt.input.Decompose()
+			if sign != tt.sign || exp != tt.exp || mant != tt.mnt {
+				t.Errorf("Float8(0x%02x).Decompose() = %d, %d, %d, want %d, %d, %d",
+					uint8(tt.input), sign, exp, mant, tt.sign, tt.exp, tt.mnt)
+			}
+			if got := tt.input.DebugString(); got != tt.debug {
+				t.Errorf("Float8(0x%02x).DebugString() = %q, want %q", uint8(tt.input), got, tt.debug)
+			}
+		})
+	}
+
+	// The hexadecimal notation must parse back to the exact value
+	for _, f := range AllFiniteValues() {
+		var literal string
+		fmt.Sscan(f.DebugString(), &literal)
+		v, err := strconv.ParseFloat(literal, 32)
+		if err != nil || float32(v) != f.ToFloat32() {
+			t.Errorf("DebugString() of 0x%02x is %q, which parses to %v, %v; want %v",
+				uint8(f), f.DebugString(), v, err, f.ToFloat32())
+		}
+	}
+}
+
+func TestIsInteger(t *testing.T) {
+	tests := []struct {
+		name string
+		f    Float8
+		want bool
+	}{
+		{"one", One(), true},
+		{"two", ToFloat8(2.0), true},
+		{"negative three", FromInt(-3), true},
+		{"one and a half", ToFloat8(1.5), false},
+		{"seven and a half", ToFloat8(7.5), false},
+		{"half", ToFloat8(0.5), false},
+		{"nine", FromInt(9), true},
+		{"max value", MaxValue, true},
+		{"min value", MinValue, true},
+		{"positive zero", PositiveZero, true},
+		{"negative zero", NegativeZero, true},
+		{"subnormal", SmallestPositive, false},
+		{"infinity", PositiveInfinity, false},
+		{"NaN", NaN, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.IsInteger(); got != tt.want {
+				t.Errorf("%v.IsInteger() = %v, want %v", tt.f, got, tt.want)
+			}
+		})
+	}
+
+	for _, f := range AllFiniteValues() {
+		v := f.ToFloat64()
+		if got, want := f.IsInteger(), v == math.Trunc(v); got != want {
+			t.Errorf("0x%02x.IsInteger() = %v, want %v", uint8(f), got, want)
+		}
+		if math.Abs(v) >= 8 && !f.IsInteger() {
+			t.Errorf("%v.IsInteger() = false for a value of magnitude >= 8", f)
+		}
+	}
+}
+
+func TestIntegerValues(t *testing.T) {
+	values := IntegerValues()
+	for i, f := range values {
+		if !f.IsInteger() {
+			t.Errorf("IntegerValues()[%d] = %v is not an integer", i, f)
+		}
+		if i > 0 && values[i-1].ToFloat32() > f.ToFloat32() {
+			t.Errorf("IntegerValues() not ascending at %d: %v before %v", i, values[i-1], f)
+		}
+	}
+
+	// Per sign: 1 through 7, plus the 46 finite magnitudes of 8 or more
+	if len(values) != 2*(7+46)+2 {
+		t.Errorf("len(IntegerValues()) = %d, want %d", len(values), 2*(7+46)+2)
+	}
+	if values[0] != MinValue || values[len(values)-1] != MaxValue {
+		t.Errorf("IntegerValues() spans %v to %v, want %v to %v", values[0], values[len(values)-1], MinValue, MaxValue)
+	}
+}
+
+func TestClampToFinite(t *testing.T) {
+	tests := []struct {
+		name string
+		f    Float8
+		want Float8
+	}{
+		{"infinity", PositiveInfinity, MaxValue},
+		{"negative infinity", NegativeInfinity, MinValue},
+		{"positive zero", PositiveZero, PositiveZero},
+		{"negative zero", NegativeZero, NegativeZero},
+		{"finite", ToFloat8(-2.5), ToFloat8(-2.5)},
+		{"max value", MaxValue, MaxValue},
+		{"NaN", NaN, NaN},
+		{"negative NaN", NegativeNaN, NegativeNaN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.ClampToFinite(); got != tt.want {
+				t.Errorf("0x%02x.ClampToFinite() = 0x%02x, want 0x%02x", uint8(tt.f), uint8(got), uint8(tt.want))
+			}
+		})
+	}
+}
+
+func TestCountRepresentable(t *testing.T) {
+	inf := float32(math.Inf(1))
+	tests := []struct {
+		name   string
+		lo, hi float32
+		want   int
+	}{
+		// Zero, 7 subnormals, 6 binades of 8 normals below 1, and 1 itself
+		{"zero to one", 0, 1, 57},
+		{"reversed", 1, 0, 57},
+		{"signed zeros count once", -0.0, 0, 1},
+		{"one to two", 1, 2, 9},
+		{"tiny interval without a value", 1.01, 1.1, 0},
+		{"tiny interval with a value", 1.12, 1.13, 1},
+		{"single point", 448, 448, 1},
+		{"beyond MaxValue", 449, 1000, 0},
+		{"whole range", -1e9, 1e9, 251},
+		{"infinite bounds", -inf, inf, 251},
+		{"negative half", -inf, -0.001, 125},
+		{"NaN bound", float32(math.NaN()), 1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CountRepresentable(tt.lo, tt.hi); got != tt.want {
+				t.Errorf("CountRepresentable(%v, %v) = %d, want %d", tt.lo, tt.hi, got, tt.want)
+			}
+		})
+	}
+}